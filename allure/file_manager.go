@@ -1,11 +1,16 @@
 package allure
 
 import (
+	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"os"
+	"path/filepath"
+	"sort"
 )
 
+const resultFileSuffix = "-result.json"
+
 type FileManager interface {
 	CreateFile(name string, content []byte) error
 }
@@ -53,6 +58,31 @@ func getResultPath() string {
 	return fmt.Sprintf("./%s", outputFolderName)
 }
 
+// ReadResults reads results of the test run printed to the directory.
+// Results are sorted by start time, so the latest attempt of the test goes last.
+func ReadResults(dir string) ([]*Result, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "*"+resultFileSuffix))
+	if err != nil {
+		return nil, err
+	}
+	results := make([]*Result, 0, len(files))
+	for _, file := range files {
+		data, err := ioutil.ReadFile(filepath.Clean(file))
+		if err != nil {
+			return nil, err
+		}
+		result := &Result{}
+		if err = json.Unmarshal(data, result); err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %w", file, err)
+		}
+		results = append(results, result)
+	}
+	sort.SliceStable(results, func(i, j int) bool {
+		return results[i].Start < results[j].Start
+	})
+	return results, nil
+}
+
 // exists returns whether the given file or directory exists
 func exists(path string) (bool, error) {
 	_, err := os.Stat(path)
@@ -59,3 +59,30 @@ func TestFileManager_CreateFile(t *testing.T) {
 	require.NoError(t, readErr)
 	require.Equal(t, fileContent, string(bytes))
 }
+
+func TestReadResults(t *testing.T) {
+	dir := t.TempDir()
+	first := NewResult("test", "Suite/test")
+	first.Start, first.Stop = 10, 20
+	second := NewResult("test", "Suite/test")
+	second.Start, second.Stop = 30, 45
+	second.Status = Failed
+	for _, result := range []*Result{second, first} {
+		data, err := result.ToJSON()
+		require.NoError(t, err)
+		require.NoError(t, ioutil.WriteFile(fmt.Sprintf("%s/%s-result.json", dir, result.UUID), data, 0o644))
+	}
+	require.NoError(t, ioutil.WriteFile(fmt.Sprintf("%s/some-container.json", dir), []byte("{}"), 0o644))
+
+	results, err := ReadResults(dir)
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+	require.Equal(t, first.UUID, results[0].UUID)
+	require.Equal(t, second.UUID, results[1].UUID)
+	require.Equal(t, Failed, results[1].Status)
+	require.Equal(t, second.HistoryID, results[1].HistoryID)
+
+	require.NoError(t, ioutil.WriteFile(fmt.Sprintf("%s/broken-result.json", dir), []byte("{"), 0o644))
+	_, err = ReadResults(dir)
+	require.Error(t, err)
+}
@@ -2,6 +2,7 @@ package allure
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 )
 
@@ -47,6 +48,8 @@ const (
 	Owner       LabelType = "owner"
 	Lead        LabelType = "lead"
 	AllureID    LabelType = "ALLURE_ID"
+	ShuffleSeed LabelType = "shuffleSeed"
+	Shard       LabelType = "shard"
 )
 
 func (l LabelType) ToString() string {
@@ -167,3 +170,13 @@ func LeadLabel(leadName string) *Label {
 func IDAllureLabel(allureID string) *Label {
 	return NewLabel(AllureID, allureID)
 }
+
+// ShuffleSeedLabel returns ShuffleSeed Label
+func ShuffleSeedLabel(seed int64) *Label {
+	return NewLabel(ShuffleSeed, strconv.FormatInt(seed, 10))
+}
+
+// ShardLabel returns Shard Label
+func ShardLabel(index, total int) *Label {
+	return NewLabel(Shard, fmt.Sprintf("%d/%d", index, total))
+}
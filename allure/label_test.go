@@ -25,6 +25,8 @@ func TestLabelType_ToString(t *testing.T) {
 	owner := "owner"
 	lead := "lead"
 	allure_id := "ALLURE_ID"
+	shuffleSeed := "shuffleSeed"
+	shard := "shard"
 
 	require.Equal(t, epic, Epic.ToString())
 	require.Equal(t, layer, Layer.ToString())
@@ -44,6 +46,8 @@ func TestLabelType_ToString(t *testing.T) {
 	require.Equal(t, owner, Owner.ToString())
 	require.Equal(t, lead, Lead.ToString())
 	require.Equal(t, allure_id, AllureID.ToString())
+	require.Equal(t, shuffleSeed, ShuffleSeed.ToString())
+	require.Equal(t, shard, Shard.ToString())
 }
 
 func TestSeverityType_ToString(t *testing.T) {
@@ -79,6 +83,8 @@ func TestLabelCreation(t *testing.T) {
 	owner := OwnerLabel("ownerTest")
 	lead := LeadLabel("leadTest")
 	idAllure := IDAllureLabel("idAllureTest")
+	shuffleSeed := ShuffleSeedLabel(42)
+	shard := ShardLabel(2, 8)
 
 	require.Equal(t, epic.Name, Epic.ToString())
 	require.Equal(t, layer.Name, Layer.ToString())
@@ -98,6 +104,8 @@ func TestLabelCreation(t *testing.T) {
 	require.Equal(t, owner.Name, Owner.ToString())
 	require.Equal(t, lead.Name, Lead.ToString())
 	require.Equal(t, idAllure.Name, AllureID.ToString())
+	require.Equal(t, shuffleSeed.Name, ShuffleSeed.ToString())
+	require.Equal(t, shard.Name, Shard.ToString())
 
 	require.Equal(t, "epicTest", epic.GetValue())
 	require.Equal(t, "featureTest", feature.GetValue())
@@ -116,4 +124,6 @@ func TestLabelCreation(t *testing.T) {
 	require.Equal(t, "ownerTest", owner.GetValue())
 	require.Equal(t, "leadTest", lead.GetValue())
 	require.Equal(t, "idAllureTest", idAllure.GetValue())
+	require.Equal(t, "42", shuffleSeed.GetValue())
+	require.Equal(t, "2/8", shard.GetValue())
 }
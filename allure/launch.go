@@ -0,0 +1,82 @@
+package allure
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	environmentFileName = "environment.properties"
+	executorFileName    = "executor.json"
+	categoriesFileName  = "categories.json"
+)
+
+// escapers of characters, which have special meaning in .properties files
+var (
+	propertyValueEscaper = strings.NewReplacer(`\`, `\\`, "\n", `\n`)
+	propertyKeyEscaper   = strings.NewReplacer(`\`, `\\`, "\n", `\n`, "=", `\=`, ":", `\:`, " ", `\ `)
+)
+
+// Executor is an implementation of the Executor entity used by Allure to show the CI build
+// the launch was run by in the report.
+type Executor struct {
+	Name       string `json:"name,omitempty"`       // Name of the executor, e.g. "Jenkins"
+	Type       string `json:"type,omitempty"`       // Type of the executor, e.g. "jenkins"
+	URL        string `json:"url,omitempty"`        // URL of the executor
+	BuildOrder int64  `json:"buildOrder,omitempty"` // Order of the build used to sort the launches in trend widgets
+	BuildName  string `json:"buildName,omitempty"`  // Name of the build
+	BuildURL   string `json:"buildUrl,omitempty"`   // URL of the build
+	ReportName string `json:"reportName,omitempty"` // Name of the report
+	ReportURL  string `json:"reportUrl,omitempty"`  // URL of the report
+}
+
+// Category is an implementation of the Category entity used by Allure to group failed and broken results
+// in the "Categories" tab of the report. Result matches the category, if it matches all set fields.
+type Category struct {
+	Name            string   `json:"name"`                      // Name of the category
+	MatchedStatuses []Status `json:"matchedStatuses,omitempty"` // Statuses of the matched results
+	MessageRegex    string   `json:"messageRegex,omitempty"`    // Regular expression for the status message of the matched results
+	TraceRegex      string   `json:"traceRegex,omitempty"`      // Regular expression for the status trace of the matched results
+	Flaky           bool     `json:"flaky,omitempty"`           // Matches flaky results only
+}
+
+// PrintEnvironment writes environment.properties shown in the "Environment" widget of the report.
+// Properties are sorted by key.
+func PrintEnvironment(environment map[string]string) error {
+	keys := make([]string, 0, len(environment))
+	for key := range environment {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var properties strings.Builder
+	for _, key := range keys {
+		_, _ = fmt.Fprintf(&properties, "%s=%s\n", propertyKeyEscaper.Replace(key), propertyValueEscaper.Replace(environment[key]))
+	}
+	err := NewFileManager().CreateFile(environmentFileName, []byte(properties.String()))
+	return errors.Wrap(err, "Error write environment")
+}
+
+// Print marshals Executor and writes it to executor.json
+func (executor *Executor) Print() error {
+	bExecutor, err := json.Marshal(executor)
+	if err != nil {
+		return errors.Wrap(err, "Failed marshal Executor")
+	}
+	err = NewFileManager().CreateFile(executorFileName, bExecutor)
+	return errors.Wrap(err, "Error write Executor")
+}
+
+// PrintCategories marshals categories and writes them to categories.json
+func PrintCategories(categories []Category) error {
+	bCategories, err := json.Marshal(categories)
+	if err != nil {
+		return errors.Wrap(err, "Failed marshal Categories")
+	}
+	err = NewFileManager().CreateFile(categoriesFileName, bCategories)
+	return errors.Wrap(err, "Error write Categories")
+}
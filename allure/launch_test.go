@@ -0,0 +1,46 @@
+package allure
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrintEnvironment(t *testing.T) {
+	defer os.RemoveAll(allureDir)
+
+	require.NoError(t, PrintEnvironment(map[string]string{
+		"stand":   "https://stage.example.com",
+		"Go arch": "amd64",
+	}))
+	content, err := ioutil.ReadFile(filepath.Join(allureDir, environmentFileName))
+	require.NoError(t, err)
+	require.Equal(t, "Go\\ arch=amd64\nstand=https://stage.example.com\n", string(content))
+}
+
+func TestExecutor_Print(t *testing.T) {
+	defer os.RemoveAll(allureDir)
+
+	executor := &Executor{Name: "Jenkins", Type: "jenkins", BuildOrder: 42}
+	require.NoError(t, executor.Print())
+	content, err := ioutil.ReadFile(filepath.Join(allureDir, executorFileName))
+	require.NoError(t, err)
+	require.JSONEq(t, `{"name": "Jenkins", "type": "jenkins", "buildOrder": 42}`, string(content))
+}
+
+func TestPrintCategories(t *testing.T) {
+	defer os.RemoveAll(allureDir)
+
+	categories := []Category{{Name: "Timeouts", MatchedStatuses: []Status{Broken}, MessageRegex: ".*timed out.*"}}
+	require.NoError(t, PrintCategories(categories))
+	content, err := ioutil.ReadFile(filepath.Join(allureDir, categoriesFileName))
+	require.NoError(t, err)
+
+	var printed []Category
+	require.NoError(t, json.Unmarshal(content, &printed))
+	require.Equal(t, categories, printed)
+}
@@ -65,6 +65,30 @@ func NewResult(testName, fullName string) *Result {
 	return &result
 }
 
+// NewAttempt Builds a new `allure.Result` for the next attempt of the same test (e.g. for retries).
+// The attempt gets its own UUID and start time and shares `Name`, `FullName`, `TestCaseID`, `HistoryID`
+// and `Description` with the current result. Labels, links and parameters are copied; status, steps
+// and attachments are not.
+func (result *Result) NewAttempt() *Result {
+	attempt := NewResult(result.Name, result.FullName)
+	attempt.TestCaseID = result.TestCaseID
+	attempt.HistoryID = result.HistoryID
+	attempt.Description = result.Description
+	attempt.ToPrint = result.ToPrint
+
+	attempt.Labels = make([]*Label, 0, len(result.Labels))
+	for _, label := range result.Labels {
+		attempt.Labels = append(attempt.Labels, &Label{Name: label.Name, Value: label.Value})
+	}
+	for _, link := range result.Links {
+		attempt.Links = append(attempt.Links, &Link{Name: link.Name, Type: link.Type, URL: link.URL})
+	}
+	for _, param := range result.Parameters {
+		attempt.Parameters = append(attempt.Parameters, &Parameter{Name: param.Name, Value: param.Value})
+	}
+	return attempt
+}
+
 func (result *Result) SetStatusMessage(msg string) {
 	result.StatusDetails.Message = msg
 }
@@ -81,6 +105,21 @@ func (result *Result) GetStatusTrace() string {
 	return result.StatusDetails.Trace
 }
 
+// SetKnown marks result as a known issue
+func (result *Result) SetKnown(known bool) {
+	result.StatusDetails.Known = known
+}
+
+// SetMuted marks result as muted
+func (result *Result) SetMuted(muted bool) {
+	result.StatusDetails.Muted = muted
+}
+
+// SetFlaky marks result as flaky
+func (result *Result) SetFlaky(flaky bool) {
+	result.StatusDetails.Flaky = flaky
+}
+
 func (result *Result) addLabel(labelType LabelType, labelValue string) {
 	label := NewLabel(labelType, labelValue)
 	result.Labels = append(result.Labels, label)
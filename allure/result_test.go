@@ -201,6 +201,70 @@ func TestResult_GetStatusTrace(t *testing.T) {
 	require.Equal(t, statusTrace, result.GetStatusTrace())
 }
 
+func TestResult_NewAttempt(t *testing.T) {
+	result := NewResult("testName", "fullName")
+	result.Description = "description"
+	result.WithSuite("suite").WithLabels(TagLabel("tag"))
+	result.Links = append(result.Links, NewLink("link", LINK, "http://test.com"))
+	result.Parameters = append(result.Parameters, NewParameter("param", "value"))
+	result.Steps = append(result.Steps, NewSimpleStep("step"))
+	result.Status = Failed
+	result.SetStatusMessage("msg")
+
+	attempt := result.NewAttempt()
+	require.NotEqual(t, result.UUID, attempt.UUID)
+	require.Equal(t, result.Name, attempt.Name)
+	require.Equal(t, result.FullName, attempt.FullName)
+	require.Equal(t, result.TestCaseID, attempt.TestCaseID)
+	require.Equal(t, result.HistoryID, attempt.HistoryID)
+	require.Equal(t, result.Description, attempt.Description)
+	require.Equal(t, result.Labels, attempt.Labels)
+	require.Equal(t, result.Links, attempt.Links)
+	require.Equal(t, result.Parameters, attempt.Parameters)
+	require.Empty(t, attempt.Status)
+	require.Empty(t, attempt.Steps)
+	require.Empty(t, attempt.GetStatusMessage())
+	require.True(t, attempt.ToPrint)
+
+	attempt.ReplaceNewLabel(Suite, "newSuite")
+	suite, _ := result.GetFirstLabel(Suite)
+	require.Equal(t, "suite", suite.GetValue())
+}
+
+func TestResult_SetKnown(t *testing.T) {
+	result := new(Result)
+	result.SetStatusMessage("statusMessageTest")
+	result.SetKnown(true)
+	require.True(t, result.StatusDetails.Known)
+	require.Equal(t, "statusMessageTest", result.GetStatusMessage())
+}
+
+func TestResult_SetMuted(t *testing.T) {
+	result := new(Result)
+	result.SetMuted(true)
+	require.True(t, result.StatusDetails.Muted)
+}
+
+func TestResult_SetFlaky(t *testing.T) {
+	result := new(Result)
+	result.SetFlaky(true)
+	require.True(t, result.StatusDetails.Flaky)
+
+	result.SetFlaky(false)
+	require.False(t, result.StatusDetails.Flaky)
+}
+
+func TestResult_ToJSON_StatusDetailsFlags(t *testing.T) {
+	result := new(Result)
+	result.SetKnown(true)
+	result.SetFlaky(true)
+	res, err := result.ToJSON()
+	require.NoError(t, err)
+	require.Contains(t, string(res), `"known":true`)
+	require.Contains(t, string(res), `"flaky":true`)
+	require.NotContains(t, string(res), `"muted"`)
+}
+
 func TestResult_SetLabel(t *testing.T) {
 	labelValue1 := "TestValue1"
 	labelValue2 := "TestValue2"
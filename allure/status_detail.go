@@ -2,6 +2,9 @@ package allure
 
 // StatusDetail ...
 type StatusDetail struct {
-	Message string `json:"message"` // Abridged version of the message
-	Trace   string `json:"trace"`   // Full message
+	Known   bool   `json:"known,omitempty"` // Marks result as a known issue
+	Muted   bool   `json:"muted,omitempty"` // Marks result as muted
+	Flaky   bool   `json:"flaky,omitempty"` // Marks result as flaky
+	Message string `json:"message"`         // Abridged version of the message
+	Trace   string `json:"trace"`           // Full message
 }
@@ -1,14 +1,15 @@
 package allure
 
 type Step struct {
-	Name        string        `json:"name,omitempty"`
-	Status      Status        `json:"status,omitempty"`
-	Attachments []*Attachment `json:"attachments,omitempty"`
-	Start       int64         `json:"start,omitempty"`
-	Stop        int64         `json:"stop,omitempty"`
-	Steps       []*Step       `json:"steps,omitempty"`
-	Parameters  []*Parameter  `json:"parameters,omitempty"`
-	parent      *Step
+	Name          string        `json:"name,omitempty"`
+	Status        Status        `json:"status,omitempty"`
+	StatusDetails *StatusDetail `json:"statusDetails,omitempty"`
+	Attachments   []*Attachment `json:"attachments,omitempty"`
+	Start         int64         `json:"start,omitempty"`
+	Stop          int64         `json:"stop,omitempty"`
+	Steps         []*Step       `json:"steps,omitempty"`
+	Parameters    []*Parameter  `json:"parameters,omitempty"`
+	parent        *Step
 }
 
 // NewStep Constructor. Creates a new `allure.Step` object with field values passed in arguments
@@ -94,6 +95,13 @@ func (s *Step) Broken() *Step {
 	return s
 }
 
+// WithStatusDetails Puts `Step.StatusDetails` with passed message and trace.
+// Returns a pointer to the current Step (for Fluent Interface).
+func (s *Step) WithStatusDetails(message, trace string) *Step {
+	s.StatusDetails = &StatusDetail{Message: message, Trace: trace}
+	return s
+}
+
 // Begin Puts `Step.Start` = `GetNow()`.
 // Returns a pointer to the current Step (for Fluent Interface).
 func (s *Step) Begin() *Step {
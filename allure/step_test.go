@@ -84,6 +84,14 @@ func TestStep_Broken(t *testing.T) {
 	require.Equal(t, Broken, step.Status)
 }
 
+func TestStep_WithStatusDetails(t *testing.T) {
+	step := new(Step)
+	step.WithStatusDetails("message", "trace")
+	require.NotNil(t, step.StatusDetails)
+	require.Equal(t, "message", step.StatusDetails.Message)
+	require.Equal(t, "trace", step.StatusDetails.Trace)
+}
+
 func TestStep_PrintAttachments(t *testing.T) {
 	attachmentText := `THIS IS A TEXT ATTACHMENT`
 	step := new(Step)
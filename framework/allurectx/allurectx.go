@@ -0,0 +1,118 @@
+// Package allurectx adds Allure steps, attachments and parameters to the step carried by context.Context.
+// It lets helper libraries (API clients, DB helpers) report their actions without accepting provider.T or provider.StepCtx:
+// the test passes t.Context() or sCtx.Context() and the library calls allurectx functions with it.
+// Steps, attachments and parameters added to the test or to the framework's step are serialized with the framework's own
+// step APIs, so all functions are safe for concurrent use, also together with provider.T and provider.StepCtx methods.
+// Functions do nothing but call the passed function if the context has no test.
+package allurectx
+
+import (
+	"context"
+	"sync"
+
+	"github.com/louisun/allure-go-v2/allure"
+	"github.com/louisun/allure-go-v2/framework/provider"
+)
+
+type stepKey struct{}
+
+// step is the step added by WithStep. It's added to the parent when finished, like the steps of the framework,
+// so changes of the step made by goroutines outliving it are ignored.
+type step struct {
+	mu       sync.Mutex
+	step     *allure.Step
+	finished bool
+}
+
+// do runs f with the step unless the step is finished
+func (s *step) do(f func(step *allure.Step)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.finished {
+		f(s.step)
+	}
+}
+
+// WithStep runs fn as the step nested into the current step of ctx. Context passed to fn carries the new step.
+// The step is failed if fn returns an error and broken if fn panics. The error of fn is returned.
+func WithStep(ctx context.Context, name string, fn func(ctx context.Context) error) (err error) {
+	if !hasTest(ctx) {
+		return fn(ctx)
+	}
+
+	current := &step{step: allure.NewSimpleStep(name)}
+	defer func() {
+		rec := recover()
+		current.mu.Lock()
+		newStep := current.step
+		newStep.Finish()
+		if rec != nil {
+			newStep.Broken()
+		} else if err != nil {
+			newStep.Failed()
+			newStep.WithAttachments(allure.NewAttachment("Error", allure.Text, []byte(err.Error())))
+		}
+		current.finished = true
+		current.mu.Unlock()
+
+		addStep(ctx, newStep)
+		if rec != nil {
+			panic(rec)
+		}
+	}()
+	return fn(context.WithValue(ctx, stepKey{}, current))
+}
+
+// Attach adds the attachment to the current step of ctx
+func Attach(ctx context.Context, name string, mimeType allure.MimeType, content []byte) {
+	if current, ok := currentStep(ctx); ok {
+		current.do(func(step *allure.Step) {
+			step.WithAttachments(allure.NewAttachment(name, mimeType, content))
+		})
+		return
+	}
+	if parent, ok := provider.StepFromContext(ctx); ok {
+		parent.WithNewAttachment(name, mimeType, content)
+	}
+}
+
+// Param adds the parameter to the current step of ctx
+func Param(ctx context.Context, name string, value interface{}) {
+	if current, ok := currentStep(ctx); ok {
+		current.do(func(step *allure.Step) { step.WithNewParameters(name, value) })
+		return
+	}
+	if parent, ok := provider.StepFromContext(ctx); ok {
+		parent.WithNewParameters(name, value)
+	}
+}
+
+// addStep adds the finished step to the current step of ctx
+func addStep(ctx context.Context, newStep *allure.Step) {
+	if current, ok := currentStep(ctx); ok {
+		current.do(func(step *allure.Step) { step.WithChild(newStep) })
+		return
+	}
+	if parent, ok := provider.StepFromContext(ctx); ok {
+		parent.Step(newStep)
+	}
+}
+
+// hasTest returns true if ctx carries the step of the test
+func hasTest(ctx context.Context) bool {
+	if _, ok := currentStep(ctx); ok {
+		return true
+	}
+	_, ok := provider.StepFromContext(ctx)
+	return ok
+}
+
+// currentStep returns the step added by WithStep, which is carried by ctx
+func currentStep(ctx context.Context) (*step, bool) {
+	if ctx == nil {
+		return nil, false
+	}
+	current, ok := ctx.Value(stepKey{}).(*step)
+	return current, ok
+}
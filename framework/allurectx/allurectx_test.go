@@ -0,0 +1,127 @@
+package allurectx
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"testing"
+
+	"github.com/louisun/allure-go-v2/allure"
+	"github.com/louisun/allure-go-v2/framework/provider"
+	"github.com/louisun/allure-go-v2/framework/runner"
+	"github.com/stretchr/testify/require"
+)
+
+func getUser(ctx context.Context, id int) error {
+	return WithStep(ctx, "Get user", func(ctx context.Context) error {
+		Param(ctx, "id", id)
+		Attach(ctx, "response", allure.JSON, []byte(`{"name": "John"}`))
+		if id < 0 {
+			return errors.New("user not found")
+		}
+		return nil
+	})
+}
+
+func TestWithStep(t *testing.T) {
+	allureDir := "./allure-results"
+	defer os.RemoveAll(allureDir)
+
+	result := runner.Run(t, "WithStep", func(t provider.T) {
+		require.NoError(t, getUser(t.Context(), 1))
+		t.WithNewStep("Users", func(sCtx provider.StepCtx) {
+			require.Error(t, getUser(sCtx.Context(), -1))
+		})
+	})
+
+	require.Len(t, result.Steps, 2)
+	step := result.Steps[0]
+	require.Equal(t, "Get user", step.Name)
+	require.Equal(t, allure.Passed, step.Status)
+	require.Equal(t, []*allure.Parameter{allure.NewParameter("id", 1)}, step.Parameters)
+	require.Len(t, step.Attachments, 1)
+
+	require.Len(t, result.Steps[1].Steps, 1)
+	step = result.Steps[1].Steps[0]
+	require.Equal(t, "Get user", step.Name)
+	require.Equal(t, allure.Failed, step.Status)
+	require.Len(t, step.Attachments, 2)
+}
+
+func TestWithStep_concurrent(t *testing.T) {
+	allureDir := "./allure-results"
+	defer os.RemoveAll(allureDir)
+
+	result := runner.Run(t, "Concurrent", func(t provider.T) {
+		_ = WithStep(t.Context(), "Get users", func(ctx context.Context) error {
+			wg := sync.WaitGroup{}
+			for i := 0; i < 10; i++ {
+				wg.Add(1)
+				go func(id int) {
+					defer wg.Done()
+					_ = getUser(ctx, id)
+				}(i)
+			}
+			wg.Wait()
+			return nil
+		})
+	})
+
+	require.Len(t, result.Steps, 1)
+	require.Len(t, result.Steps[0].Steps, 10)
+}
+
+func TestWithStep_concurrentWithFramework(t *testing.T) {
+	allureDir := "./allure-results"
+	defer os.RemoveAll(allureDir)
+
+	result := runner.Run(t, "ConcurrentWithFramework", func(t provider.T) {
+		wg := sync.WaitGroup{}
+		for i := 0; i < 10; i++ {
+			wg.Add(2)
+			go func(id int) {
+				defer wg.Done()
+				_ = getUser(t.Context(), id)
+				Param(t.Context(), fmt.Sprintf("context %d", id), id)
+			}(i)
+			go func(id int) {
+				defer wg.Done()
+				t.WithNewStep(fmt.Sprintf("Step %d", id), func(sCtx provider.StepCtx) {
+					inner := sync.WaitGroup{}
+					inner.Add(1)
+					go func() {
+						defer inner.Done()
+						_ = getUser(sCtx.Context(), id)
+					}()
+					sCtx.WithNewParameters("id", id)
+					sCtx.NewStep("Check user")
+					inner.Wait()
+				})
+				t.WithNewParameters(fmt.Sprintf("test %d", id), id)
+			}(i)
+		}
+		wg.Wait()
+	})
+
+	require.Len(t, result.Steps, 20)
+	require.Len(t, result.Parameters, 20)
+	for _, step := range result.Steps {
+		if step.Name != "Get user" {
+			require.Len(t, step.Steps, 2)
+		}
+	}
+}
+
+func TestWithStep_noTest(t *testing.T) {
+	require.NoError(t, getUser(context.Background(), 1))
+	require.Error(t, getUser(context.Background(), -1))
+
+	called := false
+	require.NoError(t, WithStep(nil, "step", func(ctx context.Context) error { // nolint: staticcheck
+		called = true
+		return nil
+	}))
+	require.True(t, called)
+}
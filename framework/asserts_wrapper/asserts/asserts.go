@@ -5,6 +5,7 @@ import (
 
 	"github.com/louisun/allure-go-v2/allure"
 	"github.com/louisun/allure-go-v2/framework/asserts_wrapper/wrapper"
+	"github.com/louisun/allure-go-v2/framework/provider"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -149,6 +150,31 @@ func JSONContains(t ProviderT, expected, actual string, msgAndArgs ...interface{
 	wrapper.NewAsserts(t).JSONContains(t, expected, actual, msgAndArgs...)
 }
 
+// JSONPathEqual ...
+func JSONPathEqual(t ProviderT, actual string, path string, expected interface{}, msgAndArgs ...interface{}) {
+	wrapper.NewAsserts(t).JSONPathEqual(t, actual, path, expected, msgAndArgs...)
+}
+
+// JSONPathExists ...
+func JSONPathExists(t ProviderT, actual string, path string, msgAndArgs ...interface{}) {
+	wrapper.NewAsserts(t).JSONPathExists(t, actual, path, msgAndArgs...)
+}
+
+// JSONPathLen ...
+func JSONPathLen(t ProviderT, actual string, path string, length int, msgAndArgs ...interface{}) {
+	wrapper.NewAsserts(t).JSONPathLen(t, actual, path, length, msgAndArgs...)
+}
+
+// MatchesJSONSchema ...
+func MatchesJSONSchema(t ProviderT, schema string, actual string, msgAndArgs ...interface{}) {
+	wrapper.NewAsserts(t).MatchesJSONSchema(t, schema, actual, msgAndArgs...)
+}
+
+// MatchesSnapshot ...
+func MatchesSnapshot(t ProviderT, name string, value interface{}, msgAndArgs ...interface{}) {
+	wrapper.NewAsserts(t).MatchesSnapshot(t, name, value, msgAndArgs...)
+}
+
 // Subset ...
 func Subset(t ProviderT, list, subset interface{}, msgAndArgs ...interface{}) {
 	wrapper.NewAsserts(t).Subset(t, list, subset, msgAndArgs...)
@@ -203,3 +229,18 @@ func Zero(t ProviderT, i interface{}, msgAndArgs ...interface{}) {
 func NotZero(t ProviderT, i interface{}, msgAndArgs ...interface{}) {
 	wrapper.NewAsserts(t).NotZero(t, i, msgAndArgs...)
 }
+
+// Eventually ...
+func Eventually(t ProviderT, condition func() bool, waitFor time.Duration, tick time.Duration, msgAndArgs ...interface{}) {
+	wrapper.NewAsserts(t).Eventually(t, condition, waitFor, tick, msgAndArgs...)
+}
+
+// Never ...
+func Never(t ProviderT, condition func() bool, waitFor time.Duration, tick time.Duration, msgAndArgs ...interface{}) {
+	wrapper.NewAsserts(t).Never(t, condition, waitFor, tick, msgAndArgs...)
+}
+
+// Expect ...
+func Expect(t ProviderT, actual interface{}) provider.Expectation {
+	return wrapper.NewAsserts(t).Expect(t, actual)
+}
@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/louisun/allure-go-v2/framework/asserts_wrapper/wrapper"
+	"github.com/louisun/allure-go-v2/framework/provider"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -147,6 +148,31 @@ func (a *a) JSONContains(expected, actual string, msgAndArgs ...interface{}) {
 	a.asserts.JSONContains(a.t, expected, actual, msgAndArgs...)
 }
 
+// JSONPathEqual ...
+func (a *a) JSONPathEqual(actual string, path string, expected interface{}, msgAndArgs ...interface{}) {
+	a.asserts.JSONPathEqual(a.t, actual, path, expected, msgAndArgs...)
+}
+
+// JSONPathExists ...
+func (a *a) JSONPathExists(actual string, path string, msgAndArgs ...interface{}) {
+	a.asserts.JSONPathExists(a.t, actual, path, msgAndArgs...)
+}
+
+// JSONPathLen ...
+func (a *a) JSONPathLen(actual string, path string, length int, msgAndArgs ...interface{}) {
+	a.asserts.JSONPathLen(a.t, actual, path, length, msgAndArgs...)
+}
+
+// MatchesJSONSchema ...
+func (a *a) MatchesJSONSchema(schema string, actual string, msgAndArgs ...interface{}) {
+	a.asserts.MatchesJSONSchema(a.t, schema, actual, msgAndArgs...)
+}
+
+// MatchesSnapshot ...
+func (a *a) MatchesSnapshot(name string, value interface{}, msgAndArgs ...interface{}) {
+	a.asserts.MatchesSnapshot(a.t, name, value, msgAndArgs...)
+}
+
 // Subset ...
 func (a *a) Subset(list, subset interface{}, msgAndArgs ...interface{}) {
 	a.asserts.Subset(a.t, list, subset, msgAndArgs...)
@@ -205,3 +231,18 @@ func (a *a) NotZero(i interface{}, msgAndArgs ...interface{}) {
 func (a *a) InDelta(expected, actual interface{}, delta float64, msgAndArgs ...interface{}) {
 	a.asserts.InDelta(a.t, expected, actual, delta, msgAndArgs...)
 }
+
+// Eventually ...
+func (a *a) Eventually(condition func() bool, waitFor time.Duration, tick time.Duration, msgAndArgs ...interface{}) {
+	a.asserts.Eventually(a.t, condition, waitFor, tick, msgAndArgs...)
+}
+
+// Never ...
+func (a *a) Never(condition func() bool, waitFor time.Duration, tick time.Duration, msgAndArgs ...interface{}) {
+	a.asserts.Never(a.t, condition, waitFor, tick, msgAndArgs...)
+}
+
+// Expect ...
+func (a *a) Expect(actual interface{}) provider.Expectation {
+	return a.asserts.Expect(a.t, actual)
+}
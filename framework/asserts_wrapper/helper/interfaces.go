@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/louisun/allure-go-v2/allure"
+	"github.com/louisun/allure-go-v2/framework/provider"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -42,6 +43,11 @@ type AssertsHelper interface {
 	WithinDuration(expected, actual time.Time, delta time.Duration, msgAndArgs ...interface{})
 	JSONEq(expected, actual string, msgAndArgs ...interface{})
 	JSONContains(expected, actual string, msgAndArgs ...interface{})
+	JSONPathEqual(actual string, path string, expected interface{}, msgAndArgs ...interface{})
+	JSONPathExists(actual string, path string, msgAndArgs ...interface{})
+	JSONPathLen(actual string, path string, length int, msgAndArgs ...interface{})
+	MatchesJSONSchema(schema string, actual string, msgAndArgs ...interface{})
+	MatchesSnapshot(name string, value interface{}, msgAndArgs ...interface{})
 	Subset(list, subset interface{}, msgAndArgs ...interface{})
 	NotSubset(list, subset interface{}, msgAndArgs ...interface{})
 	IsType(expectedType interface{}, object interface{}, msgAndArgs ...interface{})
@@ -54,4 +60,7 @@ type AssertsHelper interface {
 	Zero(i interface{}, msgAndArgs ...interface{})
 	NotZero(i interface{}, msgAndArgs ...interface{})
 	InDelta(expected, actual interface{}, delta float64, msgAndArgs ...interface{})
+	Eventually(condition func() bool, waitFor time.Duration, tick time.Duration, msgAndArgs ...interface{})
+	Never(condition func() bool, waitFor time.Duration, tick time.Duration, msgAndArgs ...interface{})
+	Expect(actual interface{}) provider.Expectation
 }
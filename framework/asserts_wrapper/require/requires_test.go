@@ -1263,6 +1263,199 @@ func TestRequireJSONContains_Fail(t *testing.T) {
 	require.Equal(t, "\n%s", mockT.errorFString)
 }
 
+func TestRequireJSONPathEqual_Success(t *testing.T) {
+	mockT := newMock()
+	actual := `{"items": [{"id": 42}, {"id": 43}]}`
+
+	JSONPathEqual(mockT, actual, "$.items[0].id", 42)
+
+	steps := mockT.steps
+	require.Len(t, steps, 1)
+	require.Equal(t, "REQUIRE: JSON Path Equal", steps[0].Name)
+	require.Equal(t, allure.Passed, steps[0].Status)
+
+	params := steps[0].Parameters
+	require.Len(t, params, 3)
+	require.Equal(t, "Path", params[0].Name)
+	require.Equal(t, "$.items[0].id", params[0].GetValue())
+	require.Equal(t, "Expected", params[1].Name)
+	require.Equal(t, "42", params[1].GetValue())
+	require.Equal(t, "Actual", params[2].Name)
+	require.Equal(t, "42", params[2].GetValue())
+
+	require.False(t, mockT.errorF)
+	require.False(t, mockT.failNow)
+	require.Empty(t, mockT.errorFString)
+}
+
+func TestRequireJSONPathEqual_Fail(t *testing.T) {
+	mockT := newMock()
+	actual := `{"items": [{"id": 42}, {"id": 43}]}`
+
+	JSONPathEqual(mockT, actual, "$.items[1].id", 42)
+
+	steps := mockT.steps
+	require.Len(t, steps, 1)
+	require.Equal(t, "REQUIRE: JSON Path Equal", steps[0].Name)
+	require.Equal(t, allure.Failed, steps[0].Status)
+
+	params := steps[0].Parameters
+	require.Len(t, params, 3)
+	require.Equal(t, "Path", params[0].Name)
+	require.Equal(t, "$.items[1].id", params[0].GetValue())
+	require.Equal(t, "Expected", params[1].Name)
+	require.Equal(t, "42", params[1].GetValue())
+	require.Equal(t, "Actual", params[2].Name)
+	require.Equal(t, "43", params[2].GetValue())
+
+	require.True(t, mockT.errorF)
+	require.True(t, mockT.failNow)
+	require.Equal(t, "\n%s", mockT.errorFString)
+}
+
+func TestRequireJSONPathExists_Success(t *testing.T) {
+	mockT := newMock()
+	actual := `{"items": [{"id": 42}, {"id": 43}]}`
+
+	JSONPathExists(mockT, actual, "$.items[1]")
+
+	steps := mockT.steps
+	require.Len(t, steps, 1)
+	require.Equal(t, "REQUIRE: JSON Path Exists", steps[0].Name)
+	require.Equal(t, allure.Passed, steps[0].Status)
+
+	params := steps[0].Parameters
+	require.Len(t, params, 2)
+	require.Equal(t, "Path", params[0].Name)
+	require.Equal(t, "$.items[1]", params[0].GetValue())
+	require.Equal(t, "Value", params[1].Name)
+	require.Equal(t, `{"id":43}`, params[1].GetValue())
+
+	require.False(t, mockT.errorF)
+	require.False(t, mockT.failNow)
+	require.Empty(t, mockT.errorFString)
+}
+
+func TestRequireJSONPathExists_Fail(t *testing.T) {
+	mockT := newMock()
+	actual := `{"items": [{"id": 42}, {"id": 43}]}`
+
+	JSONPathExists(mockT, actual, "$.items[2]")
+
+	steps := mockT.steps
+	require.Len(t, steps, 1)
+	require.Equal(t, "REQUIRE: JSON Path Exists", steps[0].Name)
+	require.Equal(t, allure.Failed, steps[0].Status)
+
+	params := steps[0].Parameters
+	require.Len(t, params, 2)
+	require.Equal(t, "Path", params[0].Name)
+	require.Equal(t, "$.items[2]", params[0].GetValue())
+	require.Equal(t, "Value", params[1].Name)
+	require.Equal(t, "<index 2 is out of range of $.items with length 2>", params[1].GetValue())
+
+	require.True(t, mockT.errorF)
+	require.True(t, mockT.failNow)
+	require.Equal(t, "\n%s", mockT.errorFString)
+}
+
+func TestRequireJSONPathLen_Success(t *testing.T) {
+	mockT := newMock()
+	actual := `{"items": [{"id": 42}, {"id": 43}]}`
+
+	JSONPathLen(mockT, actual, "$.items", 2)
+
+	steps := mockT.steps
+	require.Len(t, steps, 1)
+	require.Equal(t, "REQUIRE: JSON Path Length", steps[0].Name)
+	require.Equal(t, allure.Passed, steps[0].Status)
+
+	params := steps[0].Parameters
+	require.Len(t, params, 3)
+	require.Equal(t, "Path", params[0].Name)
+	require.Equal(t, "$.items", params[0].GetValue())
+	require.Equal(t, "Expected Len", params[1].Name)
+	require.Equal(t, "2", params[1].GetValue())
+	require.Equal(t, "Value", params[2].Name)
+	require.Equal(t, `{"id":42},{"id":43}`, params[2].GetValue())
+
+	require.False(t, mockT.errorF)
+	require.False(t, mockT.failNow)
+	require.Empty(t, mockT.errorFString)
+}
+
+func TestRequireJSONPathLen_Fail(t *testing.T) {
+	mockT := newMock()
+	actual := `{"items": [{"id": 42}, {"id": 43}]}`
+
+	JSONPathLen(mockT, actual, "$.items", 3)
+
+	steps := mockT.steps
+	require.Len(t, steps, 1)
+	require.Equal(t, "REQUIRE: JSON Path Length", steps[0].Name)
+	require.Equal(t, allure.Failed, steps[0].Status)
+
+	params := steps[0].Parameters
+	require.Len(t, params, 3)
+	require.Equal(t, "Path", params[0].Name)
+	require.Equal(t, "$.items", params[0].GetValue())
+	require.Equal(t, "Expected Len", params[1].Name)
+	require.Equal(t, "3", params[1].GetValue())
+	require.Equal(t, "Value", params[2].Name)
+	require.Equal(t, `{"id":42},{"id":43}`, params[2].GetValue())
+
+	require.True(t, mockT.errorF)
+	require.True(t, mockT.failNow)
+	require.Equal(t, "\n%s", mockT.errorFString)
+}
+
+func TestRequireMatchesJSONSchema_Success(t *testing.T) {
+	mockT := newMock()
+	actual := `{"items": [{"id": 42}, {"id": 43}]}`
+
+	MatchesJSONSchema(mockT, `{"type": "object", "required": ["items"]}`, actual)
+
+	steps := mockT.steps
+	require.Len(t, steps, 1)
+	require.Equal(t, "REQUIRE: Matches JSON Schema", steps[0].Name)
+	require.Equal(t, allure.Passed, steps[0].Status)
+
+	params := steps[0].Parameters
+	require.Len(t, params, 1)
+	require.Equal(t, "Violations", params[0].Name)
+	require.Equal(t, "0", params[0].GetValue())
+
+	require.False(t, mockT.errorF)
+	require.False(t, mockT.failNow)
+	require.Empty(t, mockT.errorFString)
+}
+
+func TestRequireMatchesJSONSchema_Fail(t *testing.T) {
+	mockT := newMock()
+	actual := `{"items": [{"id": 42}, {"id": 43}]}`
+	schema := `{"type": "object", "required": ["items", "total"]}`
+
+	MatchesJSONSchema(mockT, schema, actual)
+
+	steps := mockT.steps
+	require.Len(t, steps, 1)
+	require.Equal(t, "REQUIRE: Matches JSON Schema", steps[0].Name)
+	require.Equal(t, allure.Failed, steps[0].Status)
+
+	params := steps[0].Parameters
+	require.Len(t, params, 1)
+	require.Equal(t, "Violations", params[0].Name)
+	require.Equal(t, "1", params[0].GetValue())
+
+	require.Len(t, steps[0].Attachments, 1)
+	require.Equal(t, "Schema Violations", steps[0].Attachments[0].Name)
+	require.JSONEq(t, `[{"path": "$", "message": "required property \"total\" is missing"}]`, string(steps[0].Attachments[0].GetContent()))
+
+	require.True(t, mockT.errorF)
+	require.True(t, mockT.failNow)
+	require.Equal(t, "\n%s", mockT.errorFString)
+}
+
 func TestRequireSubset_Success(t *testing.T) {
 	mockT := newMock()
 
@@ -1740,3 +1933,42 @@ func TestRequireNotZero_Fail(t *testing.T) {
 	require.True(t, mockT.failNow)
 	require.Equal(t, "\n%s", mockT.errorFString)
 }
+
+func TestRequireExpect_Success(t *testing.T) {
+	mockT := newMock()
+
+	Expect(mockT, "test").NotToBeEmpty().ToContain("es")
+
+	steps := mockT.steps
+	require.Len(t, steps, 2)
+	require.Equal(t, "REQUIRE: Not Empty", steps[0].Name)
+	require.Equal(t, allure.Passed, steps[0].Status)
+	require.Equal(t, "REQUIRE: Contains", steps[1].Name)
+	require.Equal(t, allure.Passed, steps[1].Status)
+
+	require.False(t, mockT.errorF)
+	require.False(t, mockT.failNow)
+	require.Empty(t, mockT.errorFString)
+}
+
+func TestRequireExpect_Fail(t *testing.T) {
+	mockT := newMock()
+
+	Expect(mockT, errors.New("timeout")).ToWrap(os.ErrNotExist)
+
+	steps := mockT.steps
+	require.Len(t, steps, 1)
+	require.Equal(t, "REQUIRE: Error Is", steps[0].Name)
+	require.Equal(t, allure.Failed, steps[0].Status)
+
+	params := steps[0].Parameters
+	require.Len(t, params, 2)
+	require.Equal(t, "Error", params[0].Name)
+	require.Equal(t, "timeout", params[0].GetValue())
+	require.Equal(t, "Target", params[1].Name)
+	require.Equal(t, os.ErrNotExist.Error(), params[1].GetValue())
+
+	require.True(t, mockT.errorF)
+	require.True(t, mockT.failNow)
+	require.Equal(t, "\n%s", mockT.errorFString)
+}
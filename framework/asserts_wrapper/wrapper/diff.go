@@ -0,0 +1,155 @@
+package wrapper
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/davecgh/go-spew/spew"
+	"github.com/louisun/allure-go-v2/allure"
+	"github.com/louisun/allure-go-v2/framework/core/snapshot"
+	"github.com/pmezard/go-difflib/difflib"
+)
+
+const (
+	// maxParameterLength is the length of the value, which is too long to be shown as the step parameter.
+	// Such value is shown in the attachment of the step instead.
+	maxParameterLength = 1000
+
+	diffAttachmentName = "Diff"
+)
+
+var spewConfig = spew.ConfigState{
+	Indent:                  " ",
+	DisablePointerAddresses: true,
+	DisableCapacities:       true,
+	SortKeys:                true,
+	DisableMethods:          true,
+}
+
+// comparedValues keeps full values compared by equality-style assertion.
+// Failed step gets diff of the values and the values as attachments.
+type comparedValues struct {
+	expectedName string
+	actualName   string
+	expected     string
+	actual       string
+	mimeType     allure.MimeType
+	// dump formats the values, which are not formatted yet. Values are only formatted if they are attached
+	dump func() (expected, actual string)
+
+	truncated bool
+}
+
+// newComparedValues returns values to compare. Strings are kept as is, other values are dumped with their types
+// when they are attached to the step
+func newComparedValues(expectedName string, expected interface{}, actualName string, actual interface{}) *comparedValues {
+	values := &comparedValues{expectedName: expectedName, actualName: actualName, mimeType: allure.Text}
+	expStr, expOk := expected.(string)
+	actStr, actOk := actual.(string)
+	if expOk && actOk && reflect.TypeOf(expected) == reflect.TypeOf(actual) {
+		values.expected, values.actual = expStr, actStr
+	} else {
+		values.dump = func() (string, string) { return spewConfig.Sdump(expected), spewConfig.Sdump(actual) }
+	}
+	return values
+}
+
+// newComparedJSON formats JSON documents to compare. Valid documents are indented with sorted keys
+func newComparedJSON(expected, actual string) *comparedValues {
+	return &comparedValues{
+		expectedName: "Expected",
+		actualName:   "Actual",
+		expected:     indentJSON(expected),
+		actual:       indentJSON(actual),
+		mimeType:     allure.JSON,
+	}
+}
+
+func indentJSON(document string) string {
+	var value interface{}
+	if err := json.Unmarshal([]byte(document), &value); err != nil {
+		return document
+	}
+	indented, err := json.MarshalIndent(value, "", "  ")
+	if err != nil {
+		return document
+	}
+	return string(indented)
+}
+
+// newSnapshotValues returns snapshot and actual value to compare
+func newSnapshotValues(result *snapshot.Result) *comparedValues {
+	values := &comparedValues{
+		expectedName: "Expected",
+		actualName:   "Actual",
+		expected:     string(result.Expected),
+		actual:       string(result.Actual),
+		mimeType:     allure.Text,
+	}
+	if result.JSON {
+		values.mimeType = allure.JSON
+	}
+	return values
+}
+
+// parameters returns step parameters of the values. Values that are too long are replaced with the reference to their attachments
+func (v *comparedValues) parameters(expected, actual string) []*allure.Parameter {
+	v.truncated = isTooLongParameter(expected) || isTooLongParameter(actual)
+	return allure.NewParameters(v.expectedName, parameterValue(v.expectedName, expected), v.actualName, parameterValue(v.actualName, actual))
+}
+
+func parameterValue(name, value string) string {
+	if !isTooLongParameter(value) {
+		return value
+	}
+	return fmt.Sprintf("<%d characters, see %q attachment>", len(value), name)
+}
+
+func isTooLongParameter(value string) bool {
+	return len(value) > maxParameterLength
+}
+
+// attachments returns attachments of the step. Failed step gets diff and full values.
+// Passed step gets full values only if they didn't fit into parameters.
+func (v *comparedValues) attachments(success bool) []*allure.Attachment {
+	if success && !v.truncated {
+		return nil
+	}
+	v.format()
+
+	var attachments []*allure.Attachment
+	if !success {
+		if diff := v.diff(); diff != "" {
+			attachments = append(attachments, allure.NewAttachment(diffAttachmentName, allure.Text, []byte(diff)))
+		}
+	}
+	return append(attachments,
+		allure.NewAttachment(v.expectedName, v.mimeType, []byte(v.expected)),
+		allure.NewAttachment(v.actualName, v.mimeType, []byte(v.actual)),
+	)
+}
+
+// format dumps the values, if they are not formatted yet
+func (v *comparedValues) format() {
+	if v.dump != nil {
+		v.expected, v.actual = v.dump()
+		v.dump = nil
+	}
+}
+
+// diff returns unified diff of the values. Empty string is returned if values' representations are equal
+func (v *comparedValues) diff() string {
+	v.format()
+	if v.expected == v.actual {
+		return ""
+	}
+	diff, _ := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(v.expected),
+		B:        difflib.SplitLines(v.actual),
+		FromFile: v.expectedName,
+		ToFile:   v.actualName,
+		Context:  3,
+	})
+	return diff
+}
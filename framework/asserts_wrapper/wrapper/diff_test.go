@@ -0,0 +1,79 @@
+package wrapper
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/louisun/allure-go-v2/allure"
+)
+
+type diffTestStruct struct {
+	Name  string
+	Items []int
+}
+
+func TestComparedValues_attachments_success(t *testing.T) {
+	values := newComparedValues("Expected", 1, "Actual", 1)
+	params := values.parameters("1", "1")
+	require.Equal(t, "1", params[0].GetValue())
+	require.Equal(t, "1", params[1].GetValue())
+	require.Empty(t, values.attachments(true))
+	require.Empty(t, values.expected, "values of passed assert are not dumped")
+}
+
+func TestComparedValues_attachments_fail(t *testing.T) {
+	values := newComparedValues("Expected", diffTestStruct{Name: "a", Items: []int{1, 2}}, "Actual", diffTestStruct{Name: "a", Items: []int{1, 3}})
+	attachments := values.attachments(false)
+	require.Len(t, attachments, 3)
+
+	require.Equal(t, "Diff", attachments[0].Name)
+	require.Equal(t, allure.Text, attachments[0].Type)
+	diff := string(attachments[0].GetContent())
+	require.Contains(t, diff, "--- Expected\n+++ Actual\n")
+	require.Contains(t, diff, "-  (int) 2\n")
+	require.Contains(t, diff, "+  (int) 3\n")
+
+	require.Equal(t, "Expected", attachments[1].Name)
+	require.Contains(t, string(attachments[1].GetContent()), "(int) 2")
+	require.Equal(t, "Actual", attachments[2].Name)
+	require.Contains(t, string(attachments[2].GetContent()), "(int) 3")
+}
+
+func TestComparedValues_strings(t *testing.T) {
+	values := newComparedValues("Expected", "line1\nline2", "Actual", "line1\nline3")
+	require.Equal(t, "line1\nline2", values.expected)
+	require.Equal(t, "line1\nline3", values.actual)
+	require.Contains(t, values.diff(), "-line2\n+line3\n")
+}
+
+func TestComparedValues_longParameters(t *testing.T) {
+	long := strings.Repeat("a", maxParameterLength+1)
+	values := newComparedValues("Expected", long, "Actual", long)
+	params := values.parameters(long, long)
+	require.Equal(t, `<1001 characters, see "Expected" attachment>`, params[0].GetValue())
+	require.Equal(t, `<1001 characters, see "Actual" attachment>`, params[1].GetValue())
+
+	attachments := values.attachments(true)
+	require.Len(t, attachments, 2)
+	require.Equal(t, long, string(attachments[0].GetContent()))
+	require.Equal(t, long, string(attachments[1].GetContent()))
+}
+
+func TestComparedJSON(t *testing.T) {
+	values := newComparedJSON(`{"b": 1, "a": [1, 2]}`, `{"a": [1, 3], "b": 1}`)
+	require.Equal(t, "{\n  \"a\": [\n    1,\n    2\n  ],\n  \"b\": 1\n}", values.expected)
+
+	attachments := values.attachments(false)
+	require.Len(t, attachments, 3)
+	require.Contains(t, string(attachments[0].GetContent()), "-    2\n+    3\n")
+	require.Equal(t, allure.JSON, attachments[1].Type)
+	require.Equal(t, allure.JSON, attachments[2].Type)
+}
+
+func TestComparedJSON_invalid(t *testing.T) {
+	values := newComparedJSON(`{"a":`, `{}`)
+	require.Equal(t, `{"a":`, values.expected)
+	require.Equal(t, "{}", values.actual)
+}
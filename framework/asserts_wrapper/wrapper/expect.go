@@ -0,0 +1,357 @@
+package wrapper
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/louisun/allure-go-v2/allure"
+	"github.com/louisun/allure-go-v2/framework/provider"
+	"github.com/stretchr/testify/assert"
+)
+
+// expectation runs matchers of the chain against the actual value as steps of the asserts
+type expectation struct {
+	asserts  *asserts
+	provider Provider
+	actual   interface{}
+}
+
+// attachmentsMatcher is implemented by matchers, which add attachments to the assert step
+type attachmentsMatcher interface {
+	attachments(actual interface{}, success bool) []*allure.Attachment
+}
+
+// Expect ...
+func (a *asserts) Expect(p Provider, actual interface{}) provider.Expectation {
+	return &expectation{asserts: a, provider: p, actual: actual}
+}
+
+// To runs the matcher as the assert step
+func (e *expectation) To(matcher provider.Matcher) provider.Expectation {
+	var attachments func(success bool) []*allure.Attachment
+	if m, ok := matcher.(attachmentsMatcher); ok {
+		attachments = func(success bool) []*allure.Attachment { return m.attachments(e.actual, success) }
+	}
+	success := e.asserts.resultHelper.withNewAttachmentsStep(
+		e.asserts.t,
+		e.provider,
+		matcher.Name(),
+		func(t TestingT) bool {
+			ok, failureMessage := matcher.Match(e.actual)
+			if !ok {
+				return assert.Fail(t, failureMessage)
+			}
+			return true
+		},
+		matcher.Parameters(e.actual),
+		attachments,
+	)
+	if !success && e.asserts.resultHelper.required {
+		e.asserts.t.FailNow()
+	}
+	return e
+}
+
+// ToEqual ...
+func (e *expectation) ToEqual(expected interface{}) provider.Expectation {
+	return e.To(&equalMatcher{expected: expected})
+}
+
+// NotToEqual ...
+func (e *expectation) NotToEqual(expected interface{}) provider.Expectation {
+	return e.To(&notEqualMatcher{expected: expected})
+}
+
+// ToBeNil ...
+func (e *expectation) ToBeNil() provider.Expectation {
+	return e.To(&nilMatcher{nil: true})
+}
+
+// NotToBeNil ...
+func (e *expectation) NotToBeNil() provider.Expectation {
+	return e.To(&nilMatcher{nil: false})
+}
+
+// ToBeTrue ...
+func (e *expectation) ToBeTrue() provider.Expectation {
+	return e.To(&boolMatcher{expected: true})
+}
+
+// ToBeFalse ...
+func (e *expectation) ToBeFalse() provider.Expectation {
+	return e.To(&boolMatcher{expected: false})
+}
+
+// ToBeEmpty ...
+func (e *expectation) ToBeEmpty() provider.Expectation {
+	return e.To(&emptyMatcher{empty: true})
+}
+
+// NotToBeEmpty ...
+func (e *expectation) NotToBeEmpty() provider.Expectation {
+	return e.To(&emptyMatcher{empty: false})
+}
+
+// ToHaveLen ...
+func (e *expectation) ToHaveLen(length int) provider.Expectation {
+	return e.To(&lenMatcher{length: length})
+}
+
+// ToContain ...
+func (e *expectation) ToContain(element interface{}) provider.Expectation {
+	return e.To(&containMatcher{element: element, contain: true})
+}
+
+// NotToContain ...
+func (e *expectation) NotToContain(element interface{}) provider.Expectation {
+	return e.To(&containMatcher{element: element, contain: false})
+}
+
+// ToWrap ...
+func (e *expectation) ToWrap(target error) provider.Expectation {
+	return e.To(&wrapMatcher{target: target})
+}
+
+type equalMatcher struct {
+	expected interface{}
+	// values are kept from Parameters to be attached to the step
+	values *comparedValues
+}
+
+func (m *equalMatcher) Name() string {
+	return "Equal"
+}
+
+func (m *equalMatcher) Parameters(actual interface{}) []*allure.Parameter {
+	expString, actString := formatUnequalValues(m.expected, actual)
+	m.values = newComparedValues("Expected", m.expected, "Actual", actual)
+	return m.values.parameters(expString, actString)
+}
+
+func (m *equalMatcher) Match(actual interface{}) (bool, string) {
+	if assert.ObjectsAreEqual(m.expected, actual) {
+		return true, ""
+	}
+	expString, actString := formatUnequalValues(m.expected, actual)
+	expType, actType := reflect.TypeOf(m.expected), reflect.TypeOf(actual)
+	if expType != nil && actType != nil && expType != actType {
+		return false, fmt.Sprintf("Types are different: expected %s, actual %s\nexpected: %s\nactual  : %s", expType, actType, expString, actString)
+	}
+	return false, fmt.Sprintf("Not equal: \nexpected: %s\nactual  : %s", expString, actString)
+}
+
+func (m *equalMatcher) attachments(actual interface{}, success bool) []*allure.Attachment {
+	if m.values == nil {
+		m.Parameters(actual)
+	}
+	return m.values.attachments(success)
+}
+
+type notEqualMatcher struct {
+	expected interface{}
+}
+
+func (m *notEqualMatcher) Name() string {
+	return "Not Equal"
+}
+
+func (m *notEqualMatcher) Parameters(actual interface{}) []*allure.Parameter {
+	expString, actString := formatUnequalValues(m.expected, actual)
+	return allure.NewParameters("Expected", expString, "Actual", actString)
+}
+
+func (m *notEqualMatcher) Match(actual interface{}) (bool, string) {
+	if !assert.ObjectsAreEqual(m.expected, actual) {
+		return true, ""
+	}
+	return false, fmt.Sprintf("Should not be: %s", truncatingFormat(actual))
+}
+
+type nilMatcher struct {
+	nil bool
+}
+
+func (m *nilMatcher) Name() string {
+	if m.nil {
+		return "Nil"
+	}
+	return "Not Nil"
+}
+
+func (m *nilMatcher) Parameters(actual interface{}) []*allure.Parameter {
+	return allure.NewParameters("Actual", truncatingFormat(actual))
+}
+
+func (m *nilMatcher) Match(actual interface{}) (bool, string) {
+	switch {
+	case isNil(actual) == m.nil:
+		return true, ""
+	case m.nil:
+		return false, fmt.Sprintf("Expected nil, but got: %s", truncatingFormat(actual))
+	default:
+		return false, "Expected value not to be nil."
+	}
+}
+
+type boolMatcher struct {
+	expected bool
+}
+
+func (m *boolMatcher) Name() string {
+	if m.expected {
+		return "True"
+	}
+	return "False"
+}
+
+func (m *boolMatcher) Parameters(actual interface{}) []*allure.Parameter {
+	return allure.NewParameters("Actual Value", truncatingFormat(actual))
+}
+
+func (m *boolMatcher) Match(actual interface{}) (bool, string) {
+	value, ok := actual.(bool)
+	if !ok {
+		return false, fmt.Sprintf("Should be bool, but was %T", actual)
+	}
+	if value != m.expected {
+		return false, fmt.Sprintf("Should be %t", m.expected)
+	}
+	return true, ""
+}
+
+type emptyMatcher struct {
+	empty bool
+}
+
+func (m *emptyMatcher) Name() string {
+	if m.empty {
+		return "Empty"
+	}
+	return "Not Empty"
+}
+
+func (m *emptyMatcher) Parameters(actual interface{}) []*allure.Parameter {
+	return allure.NewParameters("Object", truncatingFormat(actual))
+}
+
+func (m *emptyMatcher) Match(actual interface{}) (bool, string) {
+	switch {
+	case assert.Empty(discardT{}, actual) == m.empty:
+		return true, ""
+	case m.empty:
+		return false, fmt.Sprintf("Should be empty, but was %v", actual)
+	default:
+		return false, fmt.Sprintf("Should NOT be empty, but was %v", actual)
+	}
+}
+
+type lenMatcher struct {
+	length int
+}
+
+func (m *lenMatcher) Name() string {
+	return "Length"
+}
+
+func (m *lenMatcher) Parameters(actual interface{}) []*allure.Parameter {
+	lenString, objString := formatUnequalValues(m.length, actual)
+	return allure.NewParameters("Actual", objString, "Expected Len", lenString)
+}
+
+func (m *lenMatcher) Match(actual interface{}) (bool, string) {
+	v := reflect.ValueOf(actual)
+	switch v.Kind() {
+	case reflect.Array, reflect.Chan, reflect.Map, reflect.Slice, reflect.String:
+	default:
+		return false, fmt.Sprintf("\"%v\" could not be applied builtin len()", actual)
+	}
+	if v.Len() != m.length {
+		return false, fmt.Sprintf("\"%v\" should have %d item(s), but has %d", actual, m.length, v.Len())
+	}
+	return true, ""
+}
+
+type containMatcher struct {
+	element interface{}
+	contain bool
+}
+
+func (m *containMatcher) Name() string {
+	if m.contain {
+		return "Contains"
+	}
+	return "Not Contains"
+}
+
+func (m *containMatcher) Parameters(actual interface{}) []*allure.Parameter {
+	sString, elementString := formatUnequalValues(actual, m.element)
+	if m.contain {
+		return allure.NewParameters("Target Struct", sString, "Should Contain", elementString)
+	}
+	return allure.NewParameters("Target Struct", sString, "Should Not Contain", elementString)
+}
+
+func (m *containMatcher) Match(actual interface{}) (bool, string) {
+	if m.contain {
+		if !assert.Contains(discardT{}, actual, m.element) {
+			return false, fmt.Sprintf("%s does not contain %s", truncatingFormat(actual), truncatingFormat(m.element))
+		}
+		return true, ""
+	}
+	if !assert.NotContains(discardT{}, actual, m.element) {
+		return false, fmt.Sprintf("%s should not contain %s", truncatingFormat(actual), truncatingFormat(m.element))
+	}
+	return true, ""
+}
+
+type wrapMatcher struct {
+	target error
+}
+
+func (m *wrapMatcher) Name() string {
+	return "Error Is"
+}
+
+func (m *wrapMatcher) Parameters(actual interface{}) []*allure.Parameter {
+	var actualString, targetString string
+	if m.target != nil {
+		targetString = m.target.Error()
+	}
+	if err, ok := actual.(error); ok {
+		actualString = err.Error()
+	} else if actual != nil {
+		actualString = truncatingFormat(actual)
+	}
+	return allure.NewParameters("Error", actualString, "Target", targetString)
+}
+
+func (m *wrapMatcher) Match(actual interface{}) (bool, string) {
+	if actual == nil {
+		return false, fmt.Sprintf("Expected error wrapping %q, but got nil", m.target)
+	}
+	err, ok := actual.(error)
+	if !ok {
+		return false, fmt.Sprintf("Should be error, but was %T", actual)
+	}
+	if !errors.Is(err, m.target) {
+		return false, fmt.Sprintf("Target error should be in err chain:\nexpected: %q\nin chain: %q", m.target, err)
+	}
+	return true, ""
+}
+
+// discardT lets testify's assertions be used as predicates of matchers
+type discardT struct{}
+
+func (discardT) Errorf(string, ...interface{}) {}
+
+func isNil(object interface{}) bool {
+	if object == nil {
+		return true
+	}
+	switch value := reflect.ValueOf(object); value.Kind() {
+	case reflect.Chan, reflect.Func, reflect.Interface, reflect.Map, reflect.Ptr, reflect.Slice, reflect.UnsafePointer:
+		return value.IsNil()
+	}
+	return false
+}
@@ -0,0 +1,261 @@
+package wrapper
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/louisun/allure-go-v2/allure"
+)
+
+var errExpectNotFound = errors.New("not found")
+
+type prefixMatcher struct {
+	prefix string
+}
+
+func (m *prefixMatcher) Name() string {
+	return "Has Prefix"
+}
+
+func (m *prefixMatcher) Parameters(actual interface{}) []*allure.Parameter {
+	return allure.NewParameters("Actual", actual, "Prefix", m.prefix)
+}
+
+func (m *prefixMatcher) Match(actual interface{}) (bool, string) {
+	str, _ := actual.(string)
+	if !strings.HasPrefix(str, m.prefix) {
+		return false, fmt.Sprintf("%q doesn't start with %q", str, m.prefix)
+	}
+	return true, ""
+}
+
+func TestAssertExpect_Success(t *testing.T) {
+	mockT := newMock()
+	items := []string{"a", "b", "c"}
+
+	NewAsserts(mockT).Expect(mockT, items).NotToBeNil().NotToBeEmpty().ToHaveLen(3).ToContain("b").NotToContain("d")
+
+	steps := mockT.steps
+	require.Len(t, steps, 5)
+	require.Equal(t, "ASSERT: Not Nil", steps[0].Name)
+	require.Equal(t, "ASSERT: Not Empty", steps[1].Name)
+	require.Equal(t, "ASSERT: Length", steps[2].Name)
+	require.Equal(t, "ASSERT: Contains", steps[3].Name)
+	require.Equal(t, "ASSERT: Not Contains", steps[4].Name)
+	for _, step := range steps {
+		require.Equal(t, allure.Passed, step.Status)
+	}
+
+	params := steps[2].Parameters
+	require.Len(t, params, 2)
+	require.Equal(t, "Actual", params[0].Name)
+	require.Equal(t, "Expected Len", params[1].Name)
+	require.Equal(t, "int(3)", params[1].GetValue())
+
+	require.False(t, mockT.errorF)
+	require.False(t, mockT.failNow)
+	require.Empty(t, mockT.errorFString)
+}
+
+func TestAssertExpect_Fail(t *testing.T) {
+	mockT := newMock()
+
+	NewAsserts(mockT).Expect(mockT, 404).ToEqual(200).NotToEqual(500)
+
+	steps := mockT.steps
+	require.Len(t, steps, 2)
+	require.Equal(t, "ASSERT: Equal", steps[0].Name)
+	require.Equal(t, allure.Failed, steps[0].Status)
+	require.Equal(t, "ASSERT: Not Equal", steps[1].Name)
+	require.Equal(t, allure.Passed, steps[1].Status)
+
+	params := steps[0].Parameters
+	require.Len(t, params, 2)
+	require.Equal(t, "Expected", params[0].Name)
+	require.Equal(t, "200", params[0].GetValue())
+	require.Equal(t, "Actual", params[1].Name)
+	require.Equal(t, "404", params[1].GetValue())
+
+	attachments := steps[0].Attachments
+	require.Len(t, attachments, 3)
+	require.Equal(t, "Diff", attachments[0].Name)
+	require.Equal(t, "Expected", attachments[1].Name)
+	require.Equal(t, "Actual", attachments[2].Name)
+
+	require.True(t, mockT.errorF)
+	require.False(t, mockT.failNow)
+	require.Equal(t, "\n%s", mockT.errorFString)
+}
+
+func TestAssertExpect_longValues(t *testing.T) {
+	mockT := newMock()
+	long := strings.Repeat("a", maxParameterLength+1)
+
+	NewAsserts(mockT).Expect(mockT, long).ToEqual(long)
+
+	steps := mockT.steps
+	require.Len(t, steps, 1)
+	require.Equal(t, allure.Passed, steps[0].Status)
+	require.Contains(t, steps[0].Parameters[0].GetValue(), "see \"Expected\" attachment")
+
+	attachments := steps[0].Attachments
+	require.Len(t, attachments, 2)
+	require.Equal(t, "Expected", attachments[0].Name)
+	require.Equal(t, "Actual", attachments[1].Name)
+}
+
+func TestRequireExpect_Success(t *testing.T) {
+	mockT := newMock()
+
+	NewRequire(mockT).Expect(mockT, true).ToBeTrue()
+
+	steps := mockT.steps
+	require.Len(t, steps, 1)
+	require.Equal(t, "REQUIRE: True", steps[0].Name)
+	require.Equal(t, allure.Passed, steps[0].Status)
+
+	require.False(t, mockT.errorF)
+	require.False(t, mockT.failNow)
+	require.Empty(t, mockT.errorFString)
+}
+
+func TestRequireExpect_Fail(t *testing.T) {
+	mockT := newMock()
+
+	NewRequire(mockT).Expect(mockT, "").ToBeFalse()
+
+	steps := mockT.steps
+	require.Len(t, steps, 1)
+	require.Equal(t, "REQUIRE: False", steps[0].Name)
+	require.Equal(t, allure.Failed, steps[0].Status)
+
+	require.True(t, mockT.errorF)
+	require.True(t, mockT.failNow)
+	require.Equal(t, "\n%s", mockT.errorFString)
+}
+
+func TestExpect_ToWrap(t *testing.T) {
+	mockT := newMock()
+	err := fmt.Errorf("user 42: %w", errExpectNotFound)
+
+	NewAsserts(mockT).Expect(mockT, err).NotToBeNil().ToWrap(errExpectNotFound)
+
+	steps := mockT.steps
+	require.Len(t, steps, 2)
+	require.Equal(t, "ASSERT: Error Is", steps[1].Name)
+	require.Equal(t, allure.Passed, steps[1].Status)
+
+	params := steps[1].Parameters
+	require.Len(t, params, 2)
+	require.Equal(t, "Error", params[0].Name)
+	require.Equal(t, "user 42: not found", params[0].GetValue())
+	require.Equal(t, "Target", params[1].Name)
+	require.Equal(t, "not found", params[1].GetValue())
+	require.False(t, mockT.errorF)
+
+	mockT = newMock()
+	NewAsserts(mockT).Expect(mockT, errors.New("timeout")).ToWrap(errExpectNotFound)
+	require.Len(t, mockT.steps, 1)
+	require.Equal(t, allure.Failed, mockT.steps[0].Status)
+	require.True(t, mockT.errorF)
+
+	mockT = newMock()
+	NewAsserts(mockT).Expect(mockT, nil).ToWrap(errExpectNotFound)
+	require.Len(t, mockT.steps, 1)
+	require.Equal(t, allure.Failed, mockT.steps[0].Status)
+	require.True(t, mockT.errorF)
+}
+
+func TestExpect_ToBeNil(t *testing.T) {
+	var err error
+	var ptr *int
+
+	mockT := newMock()
+	NewAsserts(mockT).Expect(mockT, err).ToBeNil()
+	NewAsserts(mockT).Expect(mockT, ptr).ToBeNil().ToBeEmpty()
+	require.Len(t, mockT.steps, 3)
+	for _, step := range mockT.steps {
+		require.Equal(t, allure.Passed, step.Status)
+	}
+	require.False(t, mockT.errorF)
+
+	mockT = newMock()
+	NewAsserts(mockT).Expect(mockT, 0).ToBeNil()
+	require.Len(t, mockT.steps, 1)
+	require.Equal(t, "ASSERT: Nil", mockT.steps[0].Name)
+	require.Equal(t, allure.Failed, mockT.steps[0].Status)
+	require.True(t, mockT.errorF)
+}
+
+func TestExpect_ToHaveLen_Fail(t *testing.T) {
+	mockT := newMock()
+
+	NewAsserts(mockT).Expect(mockT, 42).ToHaveLen(1).ToContain(4)
+
+	steps := mockT.steps
+	require.Len(t, steps, 2)
+	require.Equal(t, "ASSERT: Length", steps[0].Name)
+	require.Equal(t, allure.Failed, steps[0].Status)
+	require.Equal(t, "ASSERT: Contains", steps[1].Name)
+	require.Equal(t, allure.Failed, steps[1].Status)
+	require.True(t, mockT.errorF)
+	require.False(t, mockT.failNow)
+}
+
+func TestExpect_To(t *testing.T) {
+	mockT := newMock()
+
+	NewAsserts(mockT).Expect(mockT, "allure-go").To(&prefixMatcher{prefix: "allure"})
+
+	steps := mockT.steps
+	require.Len(t, steps, 1)
+	require.Equal(t, "ASSERT: Has Prefix", steps[0].Name)
+	require.Equal(t, allure.Passed, steps[0].Status)
+
+	params := steps[0].Parameters
+	require.Len(t, params, 2)
+	require.Equal(t, "Actual", params[0].Name)
+	require.Equal(t, "allure-go", params[0].GetValue())
+	require.Equal(t, "Prefix", params[1].Name)
+	require.Equal(t, "allure", params[1].GetValue())
+	require.False(t, mockT.errorF)
+
+	mockT = newMock()
+	NewRequire(mockT).Expect(mockT, "testify").To(&prefixMatcher{prefix: "allure"})
+	require.Len(t, mockT.steps, 1)
+	require.Equal(t, "REQUIRE: Has Prefix", mockT.steps[0].Name)
+	require.Equal(t, allure.Failed, mockT.steps[0].Status)
+	require.Empty(t, mockT.steps[0].Attachments)
+	require.True(t, mockT.errorF)
+	require.True(t, mockT.failNow)
+}
+
+func TestExpectMatchers_failureMessage(t *testing.T) {
+	ok, msg := (&equalMatcher{expected: 200}).Match(404)
+	require.False(t, ok)
+	require.Equal(t, "Not equal: \nexpected: 200\nactual  : 404", msg)
+
+	ok, msg = (&equalMatcher{expected: 200}).Match(int64(200))
+	require.False(t, ok)
+	require.Equal(t, "Types are different: expected int, actual int64\nexpected: int(200)\nactual  : int64(200)", msg)
+
+	ok, msg = (&lenMatcher{length: 2}).Match([]int{1})
+	require.False(t, ok)
+	require.Equal(t, "\"[1]\" should have 2 item(s), but has 1", msg)
+
+	ok, msg = (&containMatcher{element: "d", contain: true}).Match([]string{"a"})
+	require.False(t, ok)
+	require.Equal(t, "[]string{\"a\"} does not contain \"d\"", msg)
+
+	ok, msg = (&wrapMatcher{target: errExpectNotFound}).Match(errors.New("timeout"))
+	require.False(t, ok)
+	require.Equal(t, "Target error should be in err chain:\nexpected: \"not found\"\nin chain: \"timeout\"", msg)
+
+	ok, msg = (&boolMatcher{expected: true}).Match(1)
+	require.False(t, ok)
+	require.Equal(t, "Should be bool, but was int", msg)
+}
@@ -26,12 +26,20 @@ func (h *assertHelper) getStepName(assertName string, msgAndArgs ...interface{})
 }
 
 func (h *assertHelper) withNewStep(t TestingT, provider Provider, assertName string, assert func(t TestingT) bool, params []*allure.Parameter, msgAndArgs ...interface{}) bool {
+	return h.withNewAttachmentsStep(t, provider, assertName, assert, params, nil, msgAndArgs...)
+}
+
+// withNewAttachmentsStep works like withNewStep and adds attachments returned by the result of the assert to the step
+func (h *assertHelper) withNewAttachmentsStep(t TestingT, provider Provider, assertName string, assert func(t TestingT) bool, params []*allure.Parameter, attachments func(success bool) []*allure.Attachment, msgAndArgs ...interface{}) bool {
 	var result bool
 	step := allure.NewSimpleStep(h.getStepName(assertName, msgAndArgs...), params...)
 	defer func() {
 		if !result {
 			step.Failed()
 		}
+		if attachments != nil {
+			step.WithAttachments(attachments(result)...)
+		}
 		provider.Step(step)
 	}()
 	result = assert(t)
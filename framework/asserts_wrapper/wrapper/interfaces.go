@@ -3,6 +3,7 @@ package wrapper
 import (
 	"time"
 
+	"github.com/louisun/allure-go-v2/framework/provider"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -35,6 +36,11 @@ type AssertsWrapper interface {
 	WithinDuration(provider Provider, expected, actual time.Time, delta time.Duration, msgAndArgs ...interface{})
 	JSONEq(provider Provider, expected, actual string, msgAndArgs ...interface{})
 	JSONContains(provider Provider, expected, actual string, msgAndArgs ...interface{})
+	JSONPathEqual(provider Provider, actual string, path string, expected interface{}, msgAndArgs ...interface{})
+	JSONPathExists(provider Provider, actual string, path string, msgAndArgs ...interface{})
+	JSONPathLen(provider Provider, actual string, path string, length int, msgAndArgs ...interface{})
+	MatchesJSONSchema(provider Provider, schema string, actual string, msgAndArgs ...interface{})
+	MatchesSnapshot(provider Provider, name string, value interface{}, msgAndArgs ...interface{})
 	Subset(provider Provider, list, subset interface{}, msgAndArgs ...interface{})
 	NotSubset(provider Provider, list, subset interface{}, msgAndArgs ...interface{})
 	IsType(provider Provider, expectedType interface{}, object interface{}, msgAndArgs ...interface{})
@@ -47,4 +53,12 @@ type AssertsWrapper interface {
 	Zero(provider Provider, i interface{}, msgAndArgs ...interface{})
 	NotZero(provider Provider, i interface{}, msgAndArgs ...interface{})
 	InDelta(provider Provider, expected, actual interface{}, delta float64, msgAndArgs ...interface{})
+	Eventually(provider Provider, condition func() bool, waitFor time.Duration, tick time.Duration, msgAndArgs ...interface{})
+	Never(provider Provider, condition func() bool, waitFor time.Duration, tick time.Duration, msgAndArgs ...interface{})
+	Expect(provider Provider, actual interface{}) provider.Expectation
+}
+
+// snapshotLocator is implemented by tests and steps, which can match snapshots
+type snapshotLocator interface {
+	SnapshotLocation() (suite string, test string)
 }
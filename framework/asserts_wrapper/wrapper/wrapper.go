@@ -2,12 +2,14 @@ package wrapper
 
 import (
 	"bufio"
+	"encoding/json"
 	"fmt"
 	"reflect"
 	"time"
 
 	"github.com/louisun/allure-go-v2/allure"
 	coreAssert "github.com/louisun/allure-go-v2/framework/core/assert"
+	"github.com/louisun/allure-go-v2/framework/core/snapshot"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -43,12 +45,14 @@ func NewRequire(t TestingT) AssertsWrapper {
 func (a *asserts) Exactly(provider Provider, expected interface{}, actual interface{}, msgAndArgs ...interface{}) {
 	assertName := "Exactly"
 	expString, actString := formatUnequalValues(expected, actual)
-	success := a.resultHelper.withNewStep(
+	values := newComparedValues("Expected", expected, "Actual", actual)
+	success := a.resultHelper.withNewAttachmentsStep(
 		a.t,
 		provider,
 		assertName,
 		func(t TestingT) bool { return assert.Exactly(a.t, expected, actual, msgAndArgs...) },
-		allure.NewParameters("Expected", expString, "Actual", actString),
+		values.parameters(expString, actString),
+		values.attachments,
 		msgAndArgs...,
 	)
 	if !success && a.resultHelper.required {
@@ -98,12 +102,14 @@ func (a *asserts) NotSame(provider Provider, expected interface{}, actual interf
 func (a *asserts) Equal(provider Provider, expected interface{}, actual interface{}, msgAndArgs ...interface{}) {
 	assertName := "Equal"
 	expString, actString := formatUnequalValues(expected, actual)
-	success := a.resultHelper.withNewStep(
+	values := newComparedValues("Expected", expected, "Actual", actual)
+	success := a.resultHelper.withNewAttachmentsStep(
 		a.t,
 		provider,
 		assertName,
 		func(t TestingT) bool { return assert.Equal(a.t, expected, actual, msgAndArgs...) },
-		allure.NewParameters("Expected", expString, "Actual", actString),
+		values.parameters(expString, actString),
+		values.attachments,
 		msgAndArgs...,
 	)
 	if !success && a.resultHelper.required {
@@ -132,12 +138,14 @@ func (a *asserts) NotEqual(provider Provider, expected interface{}, actual inter
 func (a *asserts) EqualValues(provider Provider, expected interface{}, actual interface{}, msgAndArgs ...interface{}) {
 	assertName := "Equal Values"
 	expString, actString := formatUnequalValues(expected, actual)
-	success := a.resultHelper.withNewStep(
+	values := newComparedValues("Expected", expected, "Actual", actual)
+	success := a.resultHelper.withNewAttachmentsStep(
 		a.t,
 		provider,
 		assertName,
 		func(t TestingT) bool { return assert.EqualValues(a.t, expected, actual, msgAndArgs...) },
-		allure.NewParameters("Expected", expString, "Actual", actString),
+		values.parameters(expString, actString),
+		values.attachments,
 		msgAndArgs...,
 	)
 	if !success && a.resultHelper.required {
@@ -505,12 +513,14 @@ func (a *asserts) WithinDuration(provider Provider, expected, actual time.Time,
 // JSONEq ...
 func (a *asserts) JSONEq(provider Provider, expected, actual string, msgAndArgs ...interface{}) {
 	assertName := "JSON Equal"
-	success := a.resultHelper.withNewStep(
+	values := newComparedJSON(expected, actual)
+	success := a.resultHelper.withNewAttachmentsStep(
 		a.t,
 		provider,
 		assertName,
 		func(t TestingT) bool { return assert.JSONEq(t, expected, actual, msgAndArgs...) },
-		allure.NewParameters("Expected", expected, "Actual", actual),
+		values.parameters(expected, actual),
+		values.attachments,
 		msgAndArgs...,
 	)
 	if !success && a.resultHelper.required {
@@ -521,12 +531,130 @@ func (a *asserts) JSONEq(provider Provider, expected, actual string, msgAndArgs
 // JSONContains ...
 func (a *asserts) JSONContains(provider Provider, expected, actual string, msgAndArgs ...interface{}) {
 	assertName := "JSON Contains"
-	success := a.resultHelper.withNewStep(
+	values := newComparedJSON(expected, actual)
+	success := a.resultHelper.withNewAttachmentsStep(
 		a.t,
 		provider,
 		assertName,
 		func(t TestingT) bool { return coreAssert.JSONContains(t, expected, actual, msgAndArgs...) },
-		allure.NewParameters("Expected", expected, "Actual", actual),
+		values.parameters(expected, actual),
+		values.attachments,
+		msgAndArgs...,
+	)
+	if !success && a.resultHelper.required {
+		a.t.FailNow()
+	}
+}
+
+// JSONPathEqual ...
+func (a *asserts) JSONPathEqual(provider Provider, actual string, path string, expected interface{}, msgAndArgs ...interface{}) {
+	assertName := "JSON Path Equal"
+	success := a.resultHelper.withNewStep(
+		a.t,
+		provider,
+		assertName,
+		func(t TestingT) bool { return coreAssert.JSONPathEqual(t, actual, path, expected, msgAndArgs...) },
+		allure.NewParameters("Path", path, "Expected", coreAssert.FormatJSONValue(expected), "Actual", formatJSONPathValue(actual, path)),
+		msgAndArgs...,
+	)
+	if !success && a.resultHelper.required {
+		a.t.FailNow()
+	}
+}
+
+// JSONPathExists ...
+func (a *asserts) JSONPathExists(provider Provider, actual string, path string, msgAndArgs ...interface{}) {
+	assertName := "JSON Path Exists"
+	success := a.resultHelper.withNewStep(
+		a.t,
+		provider,
+		assertName,
+		func(t TestingT) bool { return coreAssert.JSONPathExists(t, actual, path, msgAndArgs...) },
+		allure.NewParameters("Path", path, "Value", formatJSONPathValue(actual, path)),
+		msgAndArgs...,
+	)
+	if !success && a.resultHelper.required {
+		a.t.FailNow()
+	}
+}
+
+// JSONPathLen ...
+func (a *asserts) JSONPathLen(provider Provider, actual string, path string, length int, msgAndArgs ...interface{}) {
+	assertName := "JSON Path Length"
+	success := a.resultHelper.withNewStep(
+		a.t,
+		provider,
+		assertName,
+		func(t TestingT) bool { return coreAssert.JSONPathLen(t, actual, path, length, msgAndArgs...) },
+		allure.NewParameters("Path", path, "Expected Len", length, "Value", formatJSONPathValue(actual, path)),
+		msgAndArgs...,
+	)
+	if !success && a.resultHelper.required {
+		a.t.FailNow()
+	}
+}
+
+// MatchesJSONSchema ...
+func (a *asserts) MatchesJSONSchema(provider Provider, schema string, actual string, msgAndArgs ...interface{}) {
+	assertName := "Matches JSON Schema"
+	violations, err := coreAssert.ValidateJSONSchema(schema, actual)
+	success := a.resultHelper.withNewAttachmentsStep(
+		a.t,
+		provider,
+		assertName,
+		func(t TestingT) bool { return coreAssert.NoSchemaViolations(t, actual, violations, err, msgAndArgs...) },
+		allure.NewParameters("Violations", len(violations)),
+		func(success bool) []*allure.Attachment {
+			if success || len(violations) == 0 {
+				return nil
+			}
+			content, _ := json.MarshalIndent(violations, "", "  ")
+			return []*allure.Attachment{allure.NewAttachment("Schema Violations", allure.JSON, content)}
+		},
+		msgAndArgs...,
+	)
+	if !success && a.resultHelper.required {
+		a.t.FailNow()
+	}
+}
+
+// MatchesSnapshot ...
+func (a *asserts) MatchesSnapshot(provider Provider, name string, value interface{}, msgAndArgs ...interface{}) {
+	assertName := "Matches Snapshot"
+	var (
+		result *snapshot.Result
+		err    error
+	)
+	if locator, ok := a.t.(snapshotLocator); ok {
+		suite, test := locator.SnapshotLocation()
+		result, err = snapshot.Match(suite, test, name, value)
+	} else {
+		err = fmt.Errorf("%T can't match snapshots", a.t)
+	}
+	params := allure.NewParameters("Name", name)
+	if result != nil {
+		params = append(params, allure.NewParameter("Path", result.Path))
+	}
+	success := a.resultHelper.withNewAttachmentsStep(
+		a.t,
+		provider,
+		assertName,
+		func(t TestingT) bool {
+			if err != nil {
+				return assert.Fail(t, err.Error(), msgAndArgs...)
+			}
+			if !result.Matched {
+				return assert.Fail(t, fmt.Sprintf("Value doesn't match snapshot %s. Run tests with -allure-go.update-snapshots flag to update it", result.Path), msgAndArgs...)
+			}
+			return true
+		},
+		params,
+		func(success bool) []*allure.Attachment {
+			if result == nil || success {
+				return nil
+			}
+			return newSnapshotValues(result).attachments(success)
+		},
 		msgAndArgs...,
 	)
 	if !success && a.resultHelper.required {
@@ -640,12 +768,14 @@ func (a *asserts) Regexp(provider Provider, rx interface{}, str interface{}, msg
 func (a *asserts) ElementsMatch(provider Provider, listA interface{}, listB interface{}, msgAndArgs ...interface{}) {
 	assertName := "Elements Match"
 	listAString, listBString := formatUnequalValues(listA, listB)
-	success := a.resultHelper.withNewStep(
+	values := newComparedValues("ListA", listA, "ListB", listB)
+	success := a.resultHelper.withNewAttachmentsStep(
 		a.t,
 		provider,
 		assertName,
 		func(t TestingT) bool { return assert.ElementsMatch(a.t, listA, listB, msgAndArgs...) },
-		allure.NewParameters("ListA", listAString, "ListB", listBString),
+		values.parameters(listAString, listBString),
+		values.attachments,
 		msgAndArgs...,
 	)
 	if !success && a.resultHelper.required {
@@ -734,6 +864,47 @@ func (a *asserts) InDelta(provider Provider, expected, actual interface{}, delta
 	}
 }
 
+// Eventually ...
+func (a *asserts) Eventually(provider Provider, condition func() bool, waitFor time.Duration, tick time.Duration, msgAndArgs ...interface{}) {
+	assertName := "Eventually"
+	success := a.resultHelper.withNewStep(
+		a.t,
+		provider,
+		assertName,
+		func(t TestingT) bool { return assert.Eventually(t, condition, waitFor, tick, msgAndArgs...) },
+		allure.NewParameters("Wait For", waitFor, "Tick", tick),
+		msgAndArgs...,
+	)
+	if !success && a.resultHelper.required {
+		a.t.FailNow()
+	}
+}
+
+// Never ...
+func (a *asserts) Never(provider Provider, condition func() bool, waitFor time.Duration, tick time.Duration, msgAndArgs ...interface{}) {
+	assertName := "Never"
+	success := a.resultHelper.withNewStep(
+		a.t,
+		provider,
+		assertName,
+		func(t TestingT) bool { return assert.Never(t, condition, waitFor, tick, msgAndArgs...) },
+		allure.NewParameters("Wait For", waitFor, "Tick", tick),
+		msgAndArgs...,
+	)
+	if !success && a.resultHelper.required {
+		a.t.FailNow()
+	}
+}
+
+// formatJSONPathValue returns value at the path of JSON document or the reason it can't be extracted
+func formatJSONPathValue(document string, path string) string {
+	value, err := coreAssert.ExtractJSONPath(document, path)
+	if err != nil {
+		return fmt.Sprintf("<%s>", err)
+	}
+	return coreAssert.FormatJSONValue(value)
+}
+
 // formatUnequalValues takes two values of arbitrary types and returns string
 // representations appropriate to be presented to the user.
 //
@@ -3,6 +3,7 @@ package wrapper
 import (
 	"fmt"
 	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -10,6 +11,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/louisun/allure-go-v2/allure"
+	"github.com/louisun/allure-go-v2/framework/core/snapshot"
 )
 
 type testStructSuc struct {
@@ -217,6 +219,12 @@ func TestAssertEqual_Fail(t *testing.T) {
 	require.Equal(t, "Actual", params[1].Name)
 	require.Equal(t, "2", params[1].GetValue())
 
+	attachments := mockT.steps[0].Attachments
+	require.Len(t, attachments, 3)
+	require.Equal(t, "Diff", attachments[0].Name)
+	require.Equal(t, "Expected", attachments[1].Name)
+	require.Equal(t, "Actual", attachments[2].Name)
+
 	require.True(t, mockT.errorF)
 	require.False(t, mockT.failNow)
 	require.Equal(t, "\n%s", mockT.errorFString)
@@ -1264,6 +1272,199 @@ func TestAssertJSONContains_Fail(t *testing.T) {
 	require.Equal(t, "\n%s", mockT.errorFString)
 }
 
+func TestAssertJSONPathEqual_Success(t *testing.T) {
+	mockT := newMock()
+	actual := `{"items": [{"id": 42}, {"id": 43}]}`
+
+	NewAsserts(mockT).JSONPathEqual(mockT, actual, "$.items[0].id", 42)
+
+	steps := mockT.steps
+	require.Len(t, steps, 1)
+	require.Equal(t, "ASSERT: JSON Path Equal", steps[0].Name)
+	require.Equal(t, allure.Passed, steps[0].Status)
+
+	params := steps[0].Parameters
+	require.Len(t, params, 3)
+	require.Equal(t, "Path", params[0].Name)
+	require.Equal(t, "$.items[0].id", params[0].GetValue())
+	require.Equal(t, "Expected", params[1].Name)
+	require.Equal(t, "42", params[1].GetValue())
+	require.Equal(t, "Actual", params[2].Name)
+	require.Equal(t, "42", params[2].GetValue())
+
+	require.False(t, mockT.errorF)
+	require.False(t, mockT.failNow)
+	require.Empty(t, mockT.errorFString)
+}
+
+func TestAssertJSONPathEqual_Fail(t *testing.T) {
+	mockT := newMock()
+	actual := `{"items": [{"id": 42}, {"id": 43}]}`
+
+	NewAsserts(mockT).JSONPathEqual(mockT, actual, "$.items[1].id", 42)
+
+	steps := mockT.steps
+	require.Len(t, steps, 1)
+	require.Equal(t, "ASSERT: JSON Path Equal", steps[0].Name)
+	require.Equal(t, allure.Failed, steps[0].Status)
+
+	params := steps[0].Parameters
+	require.Len(t, params, 3)
+	require.Equal(t, "Path", params[0].Name)
+	require.Equal(t, "$.items[1].id", params[0].GetValue())
+	require.Equal(t, "Expected", params[1].Name)
+	require.Equal(t, "42", params[1].GetValue())
+	require.Equal(t, "Actual", params[2].Name)
+	require.Equal(t, "43", params[2].GetValue())
+
+	require.True(t, mockT.errorF)
+	require.False(t, mockT.failNow)
+	require.Equal(t, "\n%s", mockT.errorFString)
+}
+
+func TestAssertJSONPathExists_Success(t *testing.T) {
+	mockT := newMock()
+	actual := `{"items": [{"id": 42}, {"id": 43}]}`
+
+	NewAsserts(mockT).JSONPathExists(mockT, actual, "$.items[1]")
+
+	steps := mockT.steps
+	require.Len(t, steps, 1)
+	require.Equal(t, "ASSERT: JSON Path Exists", steps[0].Name)
+	require.Equal(t, allure.Passed, steps[0].Status)
+
+	params := steps[0].Parameters
+	require.Len(t, params, 2)
+	require.Equal(t, "Path", params[0].Name)
+	require.Equal(t, "$.items[1]", params[0].GetValue())
+	require.Equal(t, "Value", params[1].Name)
+	require.Equal(t, `{"id":43}`, params[1].GetValue())
+
+	require.False(t, mockT.errorF)
+	require.False(t, mockT.failNow)
+	require.Empty(t, mockT.errorFString)
+}
+
+func TestAssertJSONPathExists_Fail(t *testing.T) {
+	mockT := newMock()
+	actual := `{"items": [{"id": 42}, {"id": 43}]}`
+
+	NewAsserts(mockT).JSONPathExists(mockT, actual, "$.items[2]")
+
+	steps := mockT.steps
+	require.Len(t, steps, 1)
+	require.Equal(t, "ASSERT: JSON Path Exists", steps[0].Name)
+	require.Equal(t, allure.Failed, steps[0].Status)
+
+	params := steps[0].Parameters
+	require.Len(t, params, 2)
+	require.Equal(t, "Path", params[0].Name)
+	require.Equal(t, "$.items[2]", params[0].GetValue())
+	require.Equal(t, "Value", params[1].Name)
+	require.Equal(t, "<index 2 is out of range of $.items with length 2>", params[1].GetValue())
+
+	require.True(t, mockT.errorF)
+	require.False(t, mockT.failNow)
+	require.Equal(t, "\n%s", mockT.errorFString)
+}
+
+func TestAssertJSONPathLen_Success(t *testing.T) {
+	mockT := newMock()
+	actual := `{"items": [{"id": 42}, {"id": 43}]}`
+
+	NewAsserts(mockT).JSONPathLen(mockT, actual, "$.items", 2)
+
+	steps := mockT.steps
+	require.Len(t, steps, 1)
+	require.Equal(t, "ASSERT: JSON Path Length", steps[0].Name)
+	require.Equal(t, allure.Passed, steps[0].Status)
+
+	params := steps[0].Parameters
+	require.Len(t, params, 3)
+	require.Equal(t, "Path", params[0].Name)
+	require.Equal(t, "$.items", params[0].GetValue())
+	require.Equal(t, "Expected Len", params[1].Name)
+	require.Equal(t, "2", params[1].GetValue())
+	require.Equal(t, "Value", params[2].Name)
+	require.Equal(t, `{"id":42},{"id":43}`, params[2].GetValue())
+
+	require.False(t, mockT.errorF)
+	require.False(t, mockT.failNow)
+	require.Empty(t, mockT.errorFString)
+}
+
+func TestAssertJSONPathLen_Fail(t *testing.T) {
+	mockT := newMock()
+	actual := `{"items": [{"id": 42}, {"id": 43}]}`
+
+	NewAsserts(mockT).JSONPathLen(mockT, actual, "$.items", 3)
+
+	steps := mockT.steps
+	require.Len(t, steps, 1)
+	require.Equal(t, "ASSERT: JSON Path Length", steps[0].Name)
+	require.Equal(t, allure.Failed, steps[0].Status)
+
+	params := steps[0].Parameters
+	require.Len(t, params, 3)
+	require.Equal(t, "Path", params[0].Name)
+	require.Equal(t, "$.items", params[0].GetValue())
+	require.Equal(t, "Expected Len", params[1].Name)
+	require.Equal(t, "3", params[1].GetValue())
+	require.Equal(t, "Value", params[2].Name)
+	require.Equal(t, `{"id":42},{"id":43}`, params[2].GetValue())
+
+	require.True(t, mockT.errorF)
+	require.False(t, mockT.failNow)
+	require.Equal(t, "\n%s", mockT.errorFString)
+}
+
+func TestAssertMatchesJSONSchema_Success(t *testing.T) {
+	mockT := newMock()
+	actual := `{"items": [{"id": 42}, {"id": 43}]}`
+
+	NewAsserts(mockT).MatchesJSONSchema(mockT, `{"type": "object", "required": ["items"]}`, actual)
+
+	steps := mockT.steps
+	require.Len(t, steps, 1)
+	require.Equal(t, "ASSERT: Matches JSON Schema", steps[0].Name)
+	require.Equal(t, allure.Passed, steps[0].Status)
+
+	params := steps[0].Parameters
+	require.Len(t, params, 1)
+	require.Equal(t, "Violations", params[0].Name)
+	require.Equal(t, "0", params[0].GetValue())
+
+	require.False(t, mockT.errorF)
+	require.False(t, mockT.failNow)
+	require.Empty(t, mockT.errorFString)
+}
+
+func TestAssertMatchesJSONSchema_Fail(t *testing.T) {
+	mockT := newMock()
+	actual := `{"items": [{"id": 42}, {"id": 43}]}`
+	schema := `{"type": "object", "required": ["items", "total"]}`
+
+	NewAsserts(mockT).MatchesJSONSchema(mockT, schema, actual)
+
+	steps := mockT.steps
+	require.Len(t, steps, 1)
+	require.Equal(t, "ASSERT: Matches JSON Schema", steps[0].Name)
+	require.Equal(t, allure.Failed, steps[0].Status)
+
+	params := steps[0].Parameters
+	require.Len(t, params, 1)
+	require.Equal(t, "Violations", params[0].Name)
+	require.Equal(t, "1", params[0].GetValue())
+
+	require.Len(t, steps[0].Attachments, 1)
+	require.Equal(t, "Schema Violations", steps[0].Attachments[0].Name)
+	require.JSONEq(t, `[{"path": "$", "message": "required property \"total\" is missing"}]`, string(steps[0].Attachments[0].GetContent()))
+
+	require.True(t, mockT.errorF)
+	require.False(t, mockT.failNow)
+	require.Equal(t, "\n%s", mockT.errorFString)
+}
+
 func TestAssertSubset_Success(t *testing.T) {
 	mockT := newMock()
 
@@ -1800,6 +2001,106 @@ func TestAssertInDelta_Fail(t *testing.T) {
 	require.Equal(t, "\n%s", mockT.errorFString)
 }
 
+func TestAssertEventually_Success(t *testing.T) {
+	mockT := newMock()
+
+	waitFor := 50 * time.Millisecond
+	tick := 10 * time.Millisecond
+	NewAsserts(mockT).Eventually(mockT, func() bool { return true }, waitFor, tick)
+
+	steps := mockT.steps
+	require.Len(t, steps, 1)
+	require.Equal(t, "ASSERT: Eventually", steps[0].Name)
+	require.Equal(t, allure.Passed, steps[0].Status)
+
+	params := steps[0].Parameters
+	require.Len(t, params, 2)
+	require.Equal(t, "Wait For", params[0].Name)
+	require.Equal(t, waitFor.String(), params[0].GetValue())
+
+	require.Equal(t, "Tick", params[1].Name)
+	require.Equal(t, tick.String(), params[1].GetValue())
+
+	require.False(t, mockT.errorF)
+	require.False(t, mockT.failNow)
+	require.Empty(t, mockT.errorFString)
+}
+
+func TestAssertEventually_Fail(t *testing.T) {
+	mockT := newMock()
+
+	waitFor := 50 * time.Millisecond
+	tick := 10 * time.Millisecond
+	NewAsserts(mockT).Eventually(mockT, func() bool { return false }, waitFor, tick)
+
+	steps := mockT.steps
+	require.Len(t, steps, 1)
+	require.Equal(t, "ASSERT: Eventually", steps[0].Name)
+	require.Equal(t, allure.Failed, steps[0].Status)
+
+	params := steps[0].Parameters
+	require.Len(t, params, 2)
+	require.Equal(t, "Wait For", params[0].Name)
+	require.Equal(t, waitFor.String(), params[0].GetValue())
+
+	require.Equal(t, "Tick", params[1].Name)
+	require.Equal(t, tick.String(), params[1].GetValue())
+
+	require.True(t, mockT.errorF)
+	require.False(t, mockT.failNow)
+	require.Equal(t, "\n%s", mockT.errorFString)
+}
+
+func TestAssertNever_Success(t *testing.T) {
+	mockT := newMock()
+
+	waitFor := 50 * time.Millisecond
+	tick := 10 * time.Millisecond
+	NewAsserts(mockT).Never(mockT, func() bool { return false }, waitFor, tick)
+
+	steps := mockT.steps
+	require.Len(t, steps, 1)
+	require.Equal(t, "ASSERT: Never", steps[0].Name)
+	require.Equal(t, allure.Passed, steps[0].Status)
+
+	params := steps[0].Parameters
+	require.Len(t, params, 2)
+	require.Equal(t, "Wait For", params[0].Name)
+	require.Equal(t, waitFor.String(), params[0].GetValue())
+
+	require.Equal(t, "Tick", params[1].Name)
+	require.Equal(t, tick.String(), params[1].GetValue())
+
+	require.False(t, mockT.errorF)
+	require.False(t, mockT.failNow)
+	require.Empty(t, mockT.errorFString)
+}
+
+func TestAssertNever_Fail(t *testing.T) {
+	mockT := newMock()
+
+	waitFor := 50 * time.Millisecond
+	tick := 10 * time.Millisecond
+	NewAsserts(mockT).Never(mockT, func() bool { return true }, waitFor, tick)
+
+	steps := mockT.steps
+	require.Len(t, steps, 1)
+	require.Equal(t, "ASSERT: Never", steps[0].Name)
+	require.Equal(t, allure.Failed, steps[0].Status)
+
+	params := steps[0].Parameters
+	require.Len(t, params, 2)
+	require.Equal(t, "Wait For", params[0].Name)
+	require.Equal(t, waitFor.String(), params[0].GetValue())
+
+	require.Equal(t, "Tick", params[1].Name)
+	require.Equal(t, tick.String(), params[1].GetValue())
+
+	require.True(t, mockT.errorF)
+	require.False(t, mockT.failNow)
+	require.Equal(t, "\n%s", mockT.errorFString)
+}
+
 func TestRequireExactly_Success(t *testing.T) {
 	mockT := newMock()
 	NewRequire(mockT).Exactly(mockT, 1, 1)
@@ -3014,6 +3315,199 @@ func TestRequireJSONContains_Fail(t *testing.T) {
 	require.Equal(t, "\n%s", mockT.errorFString)
 }
 
+func TestRequireJSONPathEqual_Success(t *testing.T) {
+	mockT := newMock()
+	actual := `{"items": [{"id": 42}, {"id": 43}]}`
+
+	NewRequire(mockT).JSONPathEqual(mockT, actual, "$.items[0].id", 42)
+
+	steps := mockT.steps
+	require.Len(t, steps, 1)
+	require.Equal(t, "REQUIRE: JSON Path Equal", steps[0].Name)
+	require.Equal(t, allure.Passed, steps[0].Status)
+
+	params := steps[0].Parameters
+	require.Len(t, params, 3)
+	require.Equal(t, "Path", params[0].Name)
+	require.Equal(t, "$.items[0].id", params[0].GetValue())
+	require.Equal(t, "Expected", params[1].Name)
+	require.Equal(t, "42", params[1].GetValue())
+	require.Equal(t, "Actual", params[2].Name)
+	require.Equal(t, "42", params[2].GetValue())
+
+	require.False(t, mockT.errorF)
+	require.False(t, mockT.failNow)
+	require.Empty(t, mockT.errorFString)
+}
+
+func TestRequireJSONPathEqual_Fail(t *testing.T) {
+	mockT := newMock()
+	actual := `{"items": [{"id": 42}, {"id": 43}]}`
+
+	NewRequire(mockT).JSONPathEqual(mockT, actual, "$.items[1].id", 42)
+
+	steps := mockT.steps
+	require.Len(t, steps, 1)
+	require.Equal(t, "REQUIRE: JSON Path Equal", steps[0].Name)
+	require.Equal(t, allure.Failed, steps[0].Status)
+
+	params := steps[0].Parameters
+	require.Len(t, params, 3)
+	require.Equal(t, "Path", params[0].Name)
+	require.Equal(t, "$.items[1].id", params[0].GetValue())
+	require.Equal(t, "Expected", params[1].Name)
+	require.Equal(t, "42", params[1].GetValue())
+	require.Equal(t, "Actual", params[2].Name)
+	require.Equal(t, "43", params[2].GetValue())
+
+	require.True(t, mockT.errorF)
+	require.True(t, mockT.failNow)
+	require.Equal(t, "\n%s", mockT.errorFString)
+}
+
+func TestRequireJSONPathExists_Success(t *testing.T) {
+	mockT := newMock()
+	actual := `{"items": [{"id": 42}, {"id": 43}]}`
+
+	NewRequire(mockT).JSONPathExists(mockT, actual, "$.items[1]")
+
+	steps := mockT.steps
+	require.Len(t, steps, 1)
+	require.Equal(t, "REQUIRE: JSON Path Exists", steps[0].Name)
+	require.Equal(t, allure.Passed, steps[0].Status)
+
+	params := steps[0].Parameters
+	require.Len(t, params, 2)
+	require.Equal(t, "Path", params[0].Name)
+	require.Equal(t, "$.items[1]", params[0].GetValue())
+	require.Equal(t, "Value", params[1].Name)
+	require.Equal(t, `{"id":43}`, params[1].GetValue())
+
+	require.False(t, mockT.errorF)
+	require.False(t, mockT.failNow)
+	require.Empty(t, mockT.errorFString)
+}
+
+func TestRequireJSONPathExists_Fail(t *testing.T) {
+	mockT := newMock()
+	actual := `{"items": [{"id": 42}, {"id": 43}]}`
+
+	NewRequire(mockT).JSONPathExists(mockT, actual, "$.items[2]")
+
+	steps := mockT.steps
+	require.Len(t, steps, 1)
+	require.Equal(t, "REQUIRE: JSON Path Exists", steps[0].Name)
+	require.Equal(t, allure.Failed, steps[0].Status)
+
+	params := steps[0].Parameters
+	require.Len(t, params, 2)
+	require.Equal(t, "Path", params[0].Name)
+	require.Equal(t, "$.items[2]", params[0].GetValue())
+	require.Equal(t, "Value", params[1].Name)
+	require.Equal(t, "<index 2 is out of range of $.items with length 2>", params[1].GetValue())
+
+	require.True(t, mockT.errorF)
+	require.True(t, mockT.failNow)
+	require.Equal(t, "\n%s", mockT.errorFString)
+}
+
+func TestRequireJSONPathLen_Success(t *testing.T) {
+	mockT := newMock()
+	actual := `{"items": [{"id": 42}, {"id": 43}]}`
+
+	NewRequire(mockT).JSONPathLen(mockT, actual, "$.items", 2)
+
+	steps := mockT.steps
+	require.Len(t, steps, 1)
+	require.Equal(t, "REQUIRE: JSON Path Length", steps[0].Name)
+	require.Equal(t, allure.Passed, steps[0].Status)
+
+	params := steps[0].Parameters
+	require.Len(t, params, 3)
+	require.Equal(t, "Path", params[0].Name)
+	require.Equal(t, "$.items", params[0].GetValue())
+	require.Equal(t, "Expected Len", params[1].Name)
+	require.Equal(t, "2", params[1].GetValue())
+	require.Equal(t, "Value", params[2].Name)
+	require.Equal(t, `{"id":42},{"id":43}`, params[2].GetValue())
+
+	require.False(t, mockT.errorF)
+	require.False(t, mockT.failNow)
+	require.Empty(t, mockT.errorFString)
+}
+
+func TestRequireJSONPathLen_Fail(t *testing.T) {
+	mockT := newMock()
+	actual := `{"items": [{"id": 42}, {"id": 43}]}`
+
+	NewRequire(mockT).JSONPathLen(mockT, actual, "$.items", 3)
+
+	steps := mockT.steps
+	require.Len(t, steps, 1)
+	require.Equal(t, "REQUIRE: JSON Path Length", steps[0].Name)
+	require.Equal(t, allure.Failed, steps[0].Status)
+
+	params := steps[0].Parameters
+	require.Len(t, params, 3)
+	require.Equal(t, "Path", params[0].Name)
+	require.Equal(t, "$.items", params[0].GetValue())
+	require.Equal(t, "Expected Len", params[1].Name)
+	require.Equal(t, "3", params[1].GetValue())
+	require.Equal(t, "Value", params[2].Name)
+	require.Equal(t, `{"id":42},{"id":43}`, params[2].GetValue())
+
+	require.True(t, mockT.errorF)
+	require.True(t, mockT.failNow)
+	require.Equal(t, "\n%s", mockT.errorFString)
+}
+
+func TestRequireMatchesJSONSchema_Success(t *testing.T) {
+	mockT := newMock()
+	actual := `{"items": [{"id": 42}, {"id": 43}]}`
+
+	NewRequire(mockT).MatchesJSONSchema(mockT, `{"type": "object", "required": ["items"]}`, actual)
+
+	steps := mockT.steps
+	require.Len(t, steps, 1)
+	require.Equal(t, "REQUIRE: Matches JSON Schema", steps[0].Name)
+	require.Equal(t, allure.Passed, steps[0].Status)
+
+	params := steps[0].Parameters
+	require.Len(t, params, 1)
+	require.Equal(t, "Violations", params[0].Name)
+	require.Equal(t, "0", params[0].GetValue())
+
+	require.False(t, mockT.errorF)
+	require.False(t, mockT.failNow)
+	require.Empty(t, mockT.errorFString)
+}
+
+func TestRequireMatchesJSONSchema_Fail(t *testing.T) {
+	mockT := newMock()
+	actual := `{"items": [{"id": 42}, {"id": 43}]}`
+	schema := `{"type": "object", "required": ["items", "total"]}`
+
+	NewRequire(mockT).MatchesJSONSchema(mockT, schema, actual)
+
+	steps := mockT.steps
+	require.Len(t, steps, 1)
+	require.Equal(t, "REQUIRE: Matches JSON Schema", steps[0].Name)
+	require.Equal(t, allure.Failed, steps[0].Status)
+
+	params := steps[0].Parameters
+	require.Len(t, params, 1)
+	require.Equal(t, "Violations", params[0].Name)
+	require.Equal(t, "1", params[0].GetValue())
+
+	require.Len(t, steps[0].Attachments, 1)
+	require.Equal(t, "Schema Violations", steps[0].Attachments[0].Name)
+	require.JSONEq(t, `[{"path": "$", "message": "required property \"total\" is missing"}]`, string(steps[0].Attachments[0].GetContent()))
+
+	require.True(t, mockT.errorF)
+	require.True(t, mockT.failNow)
+	require.Equal(t, "\n%s", mockT.errorFString)
+}
+
 func TestRequireSubset_Success(t *testing.T) {
 	mockT := newMock()
 
@@ -3549,3 +4043,193 @@ func TestRequireInDelta_Fail(t *testing.T) {
 	require.True(t, mockT.failNow)
 	require.Equal(t, "\n%s", mockT.errorFString)
 }
+
+func TestRequireEventually_Success(t *testing.T) {
+	mockT := newMock()
+
+	waitFor := 50 * time.Millisecond
+	tick := 10 * time.Millisecond
+	NewRequire(mockT).Eventually(mockT, func() bool { return true }, waitFor, tick)
+
+	steps := mockT.steps
+	require.Len(t, steps, 1)
+	require.Equal(t, "REQUIRE: Eventually", steps[0].Name)
+	require.Equal(t, allure.Passed, steps[0].Status)
+
+	params := steps[0].Parameters
+	require.Len(t, params, 2)
+	require.Equal(t, "Wait For", params[0].Name)
+	require.Equal(t, waitFor.String(), params[0].GetValue())
+
+	require.Equal(t, "Tick", params[1].Name)
+	require.Equal(t, tick.String(), params[1].GetValue())
+
+	require.False(t, mockT.errorF)
+	require.False(t, mockT.failNow)
+	require.Empty(t, mockT.errorFString)
+}
+
+func TestRequireEventually_Fail(t *testing.T) {
+	mockT := newMock()
+
+	waitFor := 50 * time.Millisecond
+	tick := 10 * time.Millisecond
+	NewRequire(mockT).Eventually(mockT, func() bool { return false }, waitFor, tick)
+
+	steps := mockT.steps
+	require.Len(t, steps, 1)
+	require.Equal(t, "REQUIRE: Eventually", steps[0].Name)
+	require.Equal(t, allure.Failed, steps[0].Status)
+
+	params := steps[0].Parameters
+	require.Len(t, params, 2)
+	require.Equal(t, "Wait For", params[0].Name)
+	require.Equal(t, waitFor.String(), params[0].GetValue())
+
+	require.Equal(t, "Tick", params[1].Name)
+	require.Equal(t, tick.String(), params[1].GetValue())
+
+	require.True(t, mockT.errorF)
+	require.True(t, mockT.failNow)
+	require.Equal(t, "\n%s", mockT.errorFString)
+}
+
+func TestRequireNever_Success(t *testing.T) {
+	mockT := newMock()
+
+	waitFor := 50 * time.Millisecond
+	tick := 10 * time.Millisecond
+	NewRequire(mockT).Never(mockT, func() bool { return false }, waitFor, tick)
+
+	steps := mockT.steps
+	require.Len(t, steps, 1)
+	require.Equal(t, "REQUIRE: Never", steps[0].Name)
+	require.Equal(t, allure.Passed, steps[0].Status)
+
+	params := steps[0].Parameters
+	require.Len(t, params, 2)
+	require.Equal(t, "Wait For", params[0].Name)
+	require.Equal(t, waitFor.String(), params[0].GetValue())
+
+	require.Equal(t, "Tick", params[1].Name)
+	require.Equal(t, tick.String(), params[1].GetValue())
+
+	require.False(t, mockT.errorF)
+	require.False(t, mockT.failNow)
+	require.Empty(t, mockT.errorFString)
+}
+
+func TestRequireNever_Fail(t *testing.T) {
+	mockT := newMock()
+
+	waitFor := 50 * time.Millisecond
+	tick := 10 * time.Millisecond
+	NewRequire(mockT).Never(mockT, func() bool { return true }, waitFor, tick)
+
+	steps := mockT.steps
+	require.Len(t, steps, 1)
+	require.Equal(t, "REQUIRE: Never", steps[0].Name)
+	require.Equal(t, allure.Failed, steps[0].Status)
+
+	params := steps[0].Parameters
+	require.Len(t, params, 2)
+	require.Equal(t, "Wait For", params[0].Name)
+	require.Equal(t, waitFor.String(), params[0].GetValue())
+
+	require.Equal(t, "Tick", params[1].Name)
+	require.Equal(t, tick.String(), params[1].GetValue())
+
+	require.True(t, mockT.errorF)
+	require.True(t, mockT.failNow)
+	require.Equal(t, "\n%s", mockT.errorFString)
+}
+
+type providerTMockSnapshot struct {
+	*providerTMock
+}
+
+func (p *providerTMockSnapshot) SnapshotLocation() (string, string) {
+	return "Suite", "TestSnapshot"
+}
+
+// writeSnapshot writes snapshot of the mock's test in the temporary package directory
+func writeSnapshot(t *testing.T, name, content string) string {
+	wd, err := os.Getwd()
+	require.NoError(t, err)
+	require.NoError(t, os.Chdir(t.TempDir()))
+	t.Cleanup(func() { _ = os.Chdir(wd) })
+
+	path := snapshot.Path("Suite", "TestSnapshot", name)
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+	return path
+}
+
+func TestAssertMatchesSnapshot_Success(t *testing.T) {
+	path := writeSnapshot(t, "user", "{\n  \"id\": 1\n}\n")
+	mockT := &providerTMockSnapshot{newMock()}
+	NewAsserts(mockT).MatchesSnapshot(mockT, "user", map[string]int{"id": 1})
+
+	steps := mockT.steps
+	require.Len(t, steps, 1)
+	require.Equal(t, "ASSERT: Matches Snapshot", steps[0].Name)
+	require.Equal(t, allure.Passed, steps[0].Status)
+	require.Empty(t, steps[0].Attachments)
+
+	params := steps[0].Parameters
+	require.Len(t, params, 2)
+	require.Equal(t, "Name", params[0].Name)
+	require.Equal(t, "user", params[0].GetValue())
+	require.Equal(t, "Path", params[1].Name)
+	require.Equal(t, path, params[1].GetValue())
+
+	require.False(t, mockT.errorF)
+	require.False(t, mockT.failNow)
+}
+
+func TestAssertMatchesSnapshot_Fail(t *testing.T) {
+	writeSnapshot(t, "user", "{\n  \"id\": 1\n}\n")
+	mockT := &providerTMockSnapshot{newMock()}
+	NewAsserts(mockT).MatchesSnapshot(mockT, "user", map[string]int{"id": 2})
+
+	steps := mockT.steps
+	require.Len(t, steps, 1)
+	require.Equal(t, allure.Failed, steps[0].Status)
+
+	attachments := steps[0].Attachments
+	require.Len(t, attachments, 3)
+	require.Equal(t, "Diff", attachments[0].Name)
+	require.Contains(t, string(attachments[0].GetContent()), "-  \"id\": 1\n+  \"id\": 2\n")
+	require.Equal(t, "Expected", attachments[1].Name)
+	require.Equal(t, allure.JSON, attachments[1].Type)
+	require.Equal(t, "Actual", attachments[2].Name)
+
+	require.True(t, mockT.errorF)
+	require.False(t, mockT.failNow)
+}
+
+func TestAssertMatchesSnapshot_NotSupported(t *testing.T) {
+	mockT := newMock()
+	NewAsserts(mockT).MatchesSnapshot(mockT, "user", "value")
+
+	steps := mockT.steps
+	require.Len(t, steps, 1)
+	require.Equal(t, allure.Failed, steps[0].Status)
+	require.Len(t, steps[0].Parameters, 1)
+	require.True(t, mockT.errorF)
+}
+
+func TestRequireMatchesSnapshot_Fail(t *testing.T) {
+	writeSnapshot(t, "user", "John")
+	mockT := &providerTMockSnapshot{newMock()}
+	NewRequire(mockT).MatchesSnapshot(mockT, "user", "Jane")
+
+	steps := mockT.steps
+	require.Len(t, steps, 1)
+	require.Equal(t, "REQUIRE: Matches Snapshot", steps[0].Name)
+	require.Equal(t, allure.Failed, steps[0].Status)
+	require.Equal(t, allure.Text, steps[0].Attachments[1].Type)
+
+	require.True(t, mockT.errorF)
+	require.True(t, mockT.failNow)
+}
@@ -14,8 +14,8 @@ type SuiteAdapter struct {
 	suiteName     string
 	parentSuite   string
 
-	beforeAll func(provider.T)
-	afterAll  func(provider.T)
+	beforeAll []provider.Hook
+	afterAll  []provider.Hook
 
 	container *allure.Container
 }
@@ -72,22 +72,22 @@ func (ctx *SuiteAdapter) GetContainer() *allure.Container {
 	return ctx.container
 }
 
-// SetBeforeAll sets before all func
-func (ctx *SuiteAdapter) SetBeforeAll(hook func(provider.T)) {
-	ctx.beforeAll = hook
+// SetBeforeAll sets before all hooks
+func (ctx *SuiteAdapter) SetBeforeAll(hooks ...provider.Hook) {
+	ctx.beforeAll = hooks
 }
 
-// SetAfterAll sets after all func
-func (ctx *SuiteAdapter) SetAfterAll(hook func(provider.T)) {
-	ctx.afterAll = hook
+// SetAfterAll sets after all hooks
+func (ctx *SuiteAdapter) SetAfterAll(hooks ...provider.Hook) {
+	ctx.afterAll = hooks
 }
 
-// GetBeforeAll returns before all func
-func (ctx *SuiteAdapter) GetBeforeAll() func(provider.T) {
+// GetBeforeAll returns before all hooks
+func (ctx *SuiteAdapter) GetBeforeAll() []provider.Hook {
 	return ctx.beforeAll
 }
 
-// GetAfterAll returns after all func
-func (ctx *SuiteAdapter) GetAfterAll() func(provider.T) {
+// GetAfterAll returns after all hooks
+func (ctx *SuiteAdapter) GetAfterAll() []provider.Hook {
 	return ctx.afterAll
 }
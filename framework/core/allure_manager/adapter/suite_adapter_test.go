@@ -40,25 +40,25 @@ func TestSuiteAdapter_GetSuiteFullName(t *testing.T) {
 }
 
 func TestSuiteAdapter_GetBeforeAll(t *testing.T) {
-	adapter := &SuiteAdapter{beforeAll: func(t provider.T) {}}
-	require.NotNil(t, adapter.GetBeforeAll())
+	adapter := &SuiteAdapter{beforeAll: []provider.Hook{{Body: func(t provider.T) {}}}}
+	require.Len(t, adapter.GetBeforeAll(), 1)
 }
 
 func TestSuiteAdapter_GetAfterAll(t *testing.T) {
-	adapter := &SuiteAdapter{afterAll: func(t provider.T) {}}
-	require.NotNil(t, adapter.GetAfterAll())
+	adapter := &SuiteAdapter{afterAll: []provider.Hook{{Body: func(t provider.T) {}}}}
+	require.Len(t, adapter.GetAfterAll(), 1)
 }
 
 func TestSuiteAdapter_SetBeforeAll(t *testing.T) {
 	adapter := &SuiteAdapter{}
-	adapter.SetBeforeAll(func(t provider.T) {})
-	require.NotNil(t, adapter.GetBeforeAll())
+	adapter.SetBeforeAll(provider.Hook{Name: "hook", Body: func(t provider.T) {}})
+	require.Len(t, adapter.GetBeforeAll(), 1)
 }
 
 func TestSuiteAdapter_SetAfterAll(t *testing.T) {
 	adapter := &SuiteAdapter{}
-	adapter.SetAfterAll(func(t provider.T) {})
-	require.NotNil(t, adapter.GetAfterAll())
+	adapter.SetAfterAll(provider.Hook{Name: "hook", Body: func(t provider.T) {}})
+	require.Len(t, adapter.GetAfterAll(), 1)
 }
 
 func TestSuiteAdapter_GetContainer(t *testing.T) {
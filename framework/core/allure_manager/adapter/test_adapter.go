@@ -13,9 +13,10 @@ import (
 // TestAdapter describes behavior of the test
 // such as before/after each function, container, test result object, and container object
 type TestAdapter struct {
-	beforeEach func(provider.T)
-	afterEach  func(provider.T)
+	beforeEach []provider.Hook
+	afterEach  []provider.Hook
 
+	name      string
 	result    *allure.Result
 	container *allure.Container
 }
@@ -45,10 +46,20 @@ func NewTestMeta(suiteFullName, suiteName, testName, packageName string, tags ..
 		WithPackage(packageName).
 		WithLabels(newTags...)
 
+	return NewTestMetaWithResult(result)
+}
+
+// NewTestMetaWithResult returns pointer to instance of TestAdapter for already built allure.Result
+func NewTestMetaWithResult(result *allure.Result) *TestAdapter {
 	container := allure.NewContainer()
 	container.AddChild(result.UUID)
 
-	return &TestAdapter{result: result, container: container}
+	return &TestAdapter{name: result.Name, result: result, container: container}
+}
+
+// GetName returns name the test was created with. Unlike the name of the result, it's not changed by the title
+func (ctx *TestAdapter) GetName() string {
+	return ctx.name
 }
 
 // GetResult returns allure.Result pointer
@@ -66,22 +77,22 @@ func (ctx *TestAdapter) GetContainer() *allure.Container {
 	return ctx.container
 }
 
-// SetBeforeEach sets before each function
-func (ctx *TestAdapter) SetBeforeEach(hook func(provider.T)) {
-	ctx.beforeEach = hook
+// SetBeforeEach sets before each hooks
+func (ctx *TestAdapter) SetBeforeEach(hooks ...provider.Hook) {
+	ctx.beforeEach = hooks
 }
 
-// GetBeforeEach returns before each function
-func (ctx *TestAdapter) GetBeforeEach() func(provider.T) {
+// GetBeforeEach returns before each hooks
+func (ctx *TestAdapter) GetBeforeEach() []provider.Hook {
 	return ctx.beforeEach
 }
 
-// SetAfterEach sets after each function
-func (ctx *TestAdapter) SetAfterEach(hook func(provider.T)) {
-	ctx.afterEach = hook
+// SetAfterEach sets after each hooks
+func (ctx *TestAdapter) SetAfterEach(hooks ...provider.Hook) {
+	ctx.afterEach = hooks
 }
 
-// GetAfterEach returns after each function
-func (ctx *TestAdapter) GetAfterEach() func(provider.T) {
+// GetAfterEach returns after each hooks
+func (ctx *TestAdapter) GetAfterEach() []provider.Hook {
 	return ctx.afterEach
 }
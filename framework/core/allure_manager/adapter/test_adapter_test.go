@@ -4,6 +4,7 @@ import (
 	"os"
 	"testing"
 
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/require"
 
 	"github.com/louisun/allure-go-v2/allure"
@@ -38,6 +39,20 @@ func TestNewTestMeta(t *testing.T) {
 
 }
 
+func TestNewTestMetaWithResult(t *testing.T) {
+	result := allure.NewResult("testName", "fullName")
+	adapter := NewTestMetaWithResult(result)
+	require.Equal(t, result, adapter.GetResult())
+	require.NotNil(t, adapter.GetContainer())
+	require.Equal(t, []uuid.UUID{result.UUID}, adapter.GetContainer().Children)
+}
+
+func TestTestAdapter_GetName(t *testing.T) {
+	adapter := NewTestMetaWithResult(allure.NewResult("testName", "fullName"))
+	adapter.GetResult().Name = "title"
+	require.Equal(t, "testName", adapter.GetName())
+}
+
 func TestTestAdapter_GetResult(t *testing.T) {
 	test := &allure.Result{}
 	adapter := TestAdapter{result: test}
@@ -53,24 +68,24 @@ func TestTestAdapter_SetResult(t *testing.T) {
 
 func TestTestAdapter_SetBeforeEach(t *testing.T) {
 	adapter := TestAdapter{}
-	adapter.SetBeforeEach(func(t provider.T) {})
-	require.NotNil(t, adapter.GetBeforeEach())
+	adapter.SetBeforeEach(provider.Hook{Name: "hook", Body: func(t provider.T) {}})
+	require.Len(t, adapter.GetBeforeEach(), 1)
 }
 
 func TestTestAdapter_SetAfterEach(t *testing.T) {
 	adapter := TestAdapter{}
-	adapter.SetAfterEach(func(t provider.T) {})
-	require.NotNil(t, adapter.GetAfterEach())
+	adapter.SetAfterEach(provider.Hook{Name: "hook", Body: func(t provider.T) {}})
+	require.Len(t, adapter.GetAfterEach(), 1)
 }
 
 func TestTestAdapter_GetBeforeEach(t *testing.T) {
-	adapter := TestAdapter{beforeEach: func(t provider.T) {}}
-	require.NotNil(t, adapter.GetBeforeEach())
+	adapter := TestAdapter{beforeEach: []provider.Hook{{Body: func(t provider.T) {}}}}
+	require.Len(t, adapter.GetBeforeEach(), 1)
 }
 
 func TestTestAdapter_GetAfterEach(t *testing.T) {
-	adapter := TestAdapter{afterEach: func(t provider.T) {}}
-	require.NotNil(t, adapter.GetAfterEach())
+	adapter := TestAdapter{afterEach: []provider.Hook{{Body: func(t provider.T) {}}}}
+	require.Len(t, adapter.GetAfterEach(), 1)
 }
 
 func TestTestAdapter_GetContainer(t *testing.T) {
@@ -11,8 +11,8 @@ import (
 type testMetaMockDescription struct {
 	result    *allure.Result
 	container *allure.Container
-	be        func(t provider.T)
-	ae        func(t provider.T)
+	be        []provider.Hook
+	ae        []provider.Hook
 }
 
 func (m *testMetaMockDescription) GetResult() *allure.Result {
@@ -27,19 +27,19 @@ func (m *testMetaMockDescription) GetContainer() *allure.Container {
 	return m.container
 }
 
-func (m *testMetaMockDescription) SetBeforeEach(hook func(t provider.T)) {
-	m.be = hook
+func (m *testMetaMockDescription) SetBeforeEach(hooks ...provider.Hook) {
+	m.be = hooks
 }
 
-func (m *testMetaMockDescription) GetBeforeEach() func(t provider.T) {
+func (m *testMetaMockDescription) GetBeforeEach() []provider.Hook {
 	return m.be
 }
 
-func (m *testMetaMockDescription) SetAfterEach(hook func(t provider.T)) {
-	m.ae = hook
+func (m *testMetaMockDescription) SetAfterEach(hooks ...provider.Hook) {
+	m.ae = hooks
 }
 
-func (m *testMetaMockDescription) GetAfterEach() func(t provider.T) {
+func (m *testMetaMockDescription) GetAfterEach() []provider.Hook {
 	return m.ae
 }
 
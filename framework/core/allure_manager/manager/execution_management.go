@@ -1,28 +1,39 @@
 package manager
 
-import "github.com/louisun/allure-go-v2/framework/core/allure_manager/ctx"
+import (
+	"github.com/louisun/allure-go-v2/framework/core/allure_manager/ctx"
+	"github.com/louisun/allure-go-v2/framework/provider"
+)
 
 // TestContext initiate test context
 func (a *allureManager) TestContext() {
-	a.executionContext = ctx.NewTestCtx(a.testMeta.GetResult())
+	a.setExecutionContext(ctx.NewTestCtx(a.testMeta.GetResult()))
 }
 
 // BeforeEachContext initiate before each context
 func (a *allureManager) BeforeEachContext() {
-	a.executionContext = ctx.NewBeforeEachCtx(a.testMeta.GetContainer())
+	a.setExecutionContext(ctx.NewBeforeEachCtx(a.testMeta.GetContainer()))
 }
 
 // AfterEachContext initiate after each context
 func (a *allureManager) AfterEachContext() {
-	a.executionContext = ctx.NewAfterEachCtx(a.testMeta.GetContainer())
+	a.setExecutionContext(ctx.NewAfterEachCtx(a.testMeta.GetContainer()))
 }
 
 // BeforeAllContext initiate before all context
 func (a *allureManager) BeforeAllContext() {
-	a.executionContext = ctx.NewBeforeAllCtx(a.suiteMeta.GetContainer())
+	a.setExecutionContext(ctx.NewBeforeAllCtx(a.suiteMeta.GetContainer()))
 }
 
 // AfterAllContext initiate after all context
 func (a *allureManager) AfterAllContext() {
-	a.executionContext = ctx.NewAfterAllCtx(a.suiteMeta.GetContainer())
+	a.setExecutionContext(ctx.NewAfterAllCtx(a.suiteMeta.GetContainer()))
+}
+
+// setExecutionContext switches context, which may be read by goroutine abandoned by timeout
+func (a *allureManager) setExecutionContext(executionContext provider.ExecutionContext) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.executionContext = executionContext
 }
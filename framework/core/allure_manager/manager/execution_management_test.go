@@ -12,8 +12,8 @@ import (
 type testMetaMockExecM struct {
 	result    *allure.Result
 	container *allure.Container
-	be        func(t provider.T)
-	ae        func(t provider.T)
+	be        []provider.Hook
+	ae        []provider.Hook
 }
 
 func (m *testMetaMockExecM) GetResult() *allure.Result {
@@ -28,26 +28,26 @@ func (m *testMetaMockExecM) GetContainer() *allure.Container {
 	return m.container
 }
 
-func (m *testMetaMockExecM) SetBeforeEach(hook func(t provider.T)) {
-	m.be = hook
+func (m *testMetaMockExecM) SetBeforeEach(hooks ...provider.Hook) {
+	m.be = hooks
 }
 
-func (m *testMetaMockExecM) GetBeforeEach() func(t provider.T) {
+func (m *testMetaMockExecM) GetBeforeEach() []provider.Hook {
 	return m.be
 }
 
-func (m *testMetaMockExecM) SetAfterEach(hook func(t provider.T)) {
-	m.ae = hook
+func (m *testMetaMockExecM) SetAfterEach(hooks ...provider.Hook) {
+	m.ae = hooks
 }
 
-func (m *testMetaMockExecM) GetAfterEach() func(t provider.T) {
+func (m *testMetaMockExecM) GetAfterEach() []provider.Hook {
 	return m.ae
 }
 
 type suiteMetaMockExecM struct {
 	name      string
 	container *allure.Container
-	hook      func(t provider.T)
+	hook      []provider.Hook
 }
 
 func (m *suiteMetaMockExecM) GetPackageName() string {
@@ -74,19 +74,19 @@ func (m *suiteMetaMockExecM) GetContainer() *allure.Container {
 	return m.container
 }
 
-func (m *suiteMetaMockExecM) SetBeforeAll(hook func(provider.T)) {
-	m.hook = hook
+func (m *suiteMetaMockExecM) SetBeforeAll(hooks ...provider.Hook) {
+	m.hook = hooks
 }
 
-func (m *suiteMetaMockExecM) SetAfterAll(hook func(provider.T)) {
-	m.hook = hook
+func (m *suiteMetaMockExecM) SetAfterAll(hooks ...provider.Hook) {
+	m.hook = hooks
 }
 
-func (m *suiteMetaMockExecM) GetBeforeAll() func(provider.T) {
+func (m *suiteMetaMockExecM) GetBeforeAll() []provider.Hook {
 	return m.hook
 }
 
-func (m *suiteMetaMockExecM) GetAfterAll() func(provider.T) {
+func (m *suiteMetaMockExecM) GetAfterAll() []provider.Hook {
 	return m.hook
 }
 
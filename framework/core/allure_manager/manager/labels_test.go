@@ -11,8 +11,8 @@ import (
 type testMetaMockLabels struct {
 	result    *allure.Result
 	container *allure.Container
-	be        func(t provider.T)
-	ae        func(t provider.T)
+	be        []provider.Hook
+	ae        []provider.Hook
 }
 
 func (m *testMetaMockLabels) GetResult() *allure.Result {
@@ -27,19 +27,19 @@ func (m *testMetaMockLabels) GetContainer() *allure.Container {
 	return m.container
 }
 
-func (m *testMetaMockLabels) SetBeforeEach(hook func(t provider.T)) {
-	m.be = hook
+func (m *testMetaMockLabels) SetBeforeEach(hooks ...provider.Hook) {
+	m.be = hooks
 }
 
-func (m *testMetaMockLabels) GetBeforeEach() func(t provider.T) {
+func (m *testMetaMockLabels) GetBeforeEach() []provider.Hook {
 	return m.be
 }
 
-func (m *testMetaMockLabels) SetAfterEach(hook func(t provider.T)) {
-	m.ae = hook
+func (m *testMetaMockLabels) SetAfterEach(hooks ...provider.Hook) {
+	m.ae = hooks
 }
 
-func (m *testMetaMockLabels) GetAfterEach() func(t provider.T) {
+func (m *testMetaMockLabels) GetAfterEach() []provider.Hook {
 	return m.ae
 }
 
@@ -11,8 +11,8 @@ import (
 type testMetaMockLinks struct {
 	result    *allure.Result
 	container *allure.Container
-	be        func(t provider.T)
-	ae        func(t provider.T)
+	be        []provider.Hook
+	ae        []provider.Hook
 }
 
 func (m *testMetaMockLinks) GetResult() *allure.Result {
@@ -27,19 +27,19 @@ func (m *testMetaMockLinks) GetContainer() *allure.Container {
 	return m.container
 }
 
-func (m *testMetaMockLinks) SetBeforeEach(hook func(t provider.T)) {
-	m.be = hook
+func (m *testMetaMockLinks) SetBeforeEach(hooks ...provider.Hook) {
+	m.be = hooks
 }
 
-func (m *testMetaMockLinks) GetBeforeEach() func(t provider.T) {
+func (m *testMetaMockLinks) GetBeforeEach() []provider.Hook {
 	return m.be
 }
 
-func (m *testMetaMockLinks) SetAfterEach(hook func(t provider.T)) {
-	m.ae = hook
+func (m *testMetaMockLinks) SetAfterEach(hooks ...provider.Hook) {
+	m.ae = hooks
 }
 
-func (m *testMetaMockLinks) GetAfterEach() func(t provider.T) {
+func (m *testMetaMockLinks) GetAfterEach() []provider.Hook {
 	return m.ae
 }
 
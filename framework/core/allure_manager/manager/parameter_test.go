@@ -11,8 +11,8 @@ import (
 type testMetaMockParameter struct {
 	result    *allure.Result
 	container *allure.Container
-	be        func(t provider.T)
-	ae        func(t provider.T)
+	be        []provider.Hook
+	ae        []provider.Hook
 }
 
 func (m *testMetaMockParameter) GetResult() *allure.Result {
@@ -27,19 +27,19 @@ func (m *testMetaMockParameter) GetContainer() *allure.Container {
 	return m.container
 }
 
-func (m *testMetaMockParameter) SetBeforeEach(hook func(t provider.T)) {
-	m.be = hook
+func (m *testMetaMockParameter) SetBeforeEach(hooks ...provider.Hook) {
+	m.be = hooks
 }
 
-func (m *testMetaMockParameter) GetBeforeEach() func(t provider.T) {
+func (m *testMetaMockParameter) GetBeforeEach() []provider.Hook {
 	return m.be
 }
 
-func (m *testMetaMockParameter) SetAfterEach(hook func(t provider.T)) {
-	m.ae = hook
+func (m *testMetaMockParameter) SetAfterEach(hooks ...provider.Hook) {
+	m.ae = hooks
 }
 
-func (m *testMetaMockParameter) GetAfterEach() func(t provider.T) {
+func (m *testMetaMockParameter) GetAfterEach() []provider.Hook {
 	return m.ae
 }
 
@@ -1,6 +1,8 @@
 package manager
 
 import (
+	"sync"
+
 	"github.com/louisun/allure-go-v2/allure"
 	"github.com/louisun/allure-go-v2/framework/core/allure_manager/adapter"
 	"github.com/louisun/allure-go-v2/framework/provider"
@@ -10,6 +12,7 @@ type allureManager struct {
 	testMeta  provider.TestMeta
 	suiteMeta provider.SuiteMeta
 
+	mu               sync.RWMutex
 	executionContext provider.ExecutionContext
 }
 
@@ -53,6 +56,9 @@ func (a *allureManager) GetSuiteMeta() provider.SuiteMeta {
 }
 
 func (a *allureManager) ExecutionContext() provider.ExecutionContext {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
 	return a.executionContext
 }
 
@@ -16,8 +16,8 @@ import (
 type testMetaMockProvider struct {
 	result    *allure.Result
 	container *allure.Container
-	be        func(t provider.T)
-	ae        func(t provider.T)
+	be        []provider.Hook
+	ae        []provider.Hook
 }
 
 func (m *testMetaMockProvider) GetResult() *allure.Result {
@@ -32,19 +32,19 @@ func (m *testMetaMockProvider) GetContainer() *allure.Container {
 	return m.container
 }
 
-func (m *testMetaMockProvider) SetBeforeEach(hook func(t provider.T)) {
-	m.be = hook
+func (m *testMetaMockProvider) SetBeforeEach(hooks ...provider.Hook) {
+	m.be = hooks
 }
 
-func (m *testMetaMockProvider) GetBeforeEach() func(t provider.T) {
+func (m *testMetaMockProvider) GetBeforeEach() []provider.Hook {
 	return m.be
 }
 
-func (m *testMetaMockProvider) SetAfterEach(hook func(t provider.T)) {
-	m.ae = hook
+func (m *testMetaMockProvider) SetAfterEach(hooks ...provider.Hook) {
+	m.ae = hooks
 }
 
-func (m *testMetaMockProvider) GetAfterEach() func(t provider.T) {
+func (m *testMetaMockProvider) GetAfterEach() []provider.Hook {
 	return m.ae
 }
 
@@ -52,7 +52,7 @@ type suiteMetaMockProvider struct {
 	namePrefix string
 	name       string
 	container  *allure.Container
-	hook       func(t provider.T)
+	hook       []provider.Hook
 }
 
 func (m *suiteMetaMockProvider) GetPackageName() string {
@@ -75,19 +75,19 @@ func (m *suiteMetaMockProvider) GetContainer() *allure.Container {
 	return m.container
 }
 
-func (m *suiteMetaMockProvider) SetBeforeAll(hook func(provider.T)) {
-	m.hook = hook
+func (m *suiteMetaMockProvider) SetBeforeAll(hooks ...provider.Hook) {
+	m.hook = hooks
 }
 
-func (m *suiteMetaMockProvider) SetAfterAll(hook func(provider.T)) {
-	m.hook = hook
+func (m *suiteMetaMockProvider) SetAfterAll(hooks ...provider.Hook) {
+	m.hook = hooks
 }
 
-func (m *suiteMetaMockProvider) GetBeforeAll() func(provider.T) {
+func (m *suiteMetaMockProvider) GetBeforeAll() []provider.Hook {
 	return m.hook
 }
 
-func (m *suiteMetaMockProvider) GetAfterAll() func(provider.T) {
+func (m *suiteMetaMockProvider) GetAfterAll() []provider.Hook {
 	return m.hook
 }
 
@@ -127,6 +127,16 @@ func TestAllureManager_UpdateResultStatus(t *testing.T) {
 	require.Equal(t, "TestTrace", manager.GetResult().StatusDetails.Trace)
 }
 
+func TestAllureManager_UpdateResultStatus_KeepStatusDetailsFlags(t *testing.T) {
+	manager := allureManager{testMeta: &testMetaMockProvider{result: &allure.Result{}}}
+	manager.Flaky()
+	manager.Known()
+	manager.UpdateResultStatus("TestMsg", "TestTrace")
+	require.Equal(t, "TestMsg", manager.GetResult().StatusDetails.Message)
+	require.True(t, manager.GetResult().StatusDetails.Flaky)
+	require.True(t, manager.GetResult().StatusDetails.Known)
+}
+
 func TestAllureManager_StopResult(t *testing.T) {
 	manager := allureManager{testMeta: &testMetaMockProvider{result: &allure.Result{}}}
 	manager.StopResult(allure.Unknown)
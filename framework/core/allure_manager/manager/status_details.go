@@ -0,0 +1,32 @@
+package manager
+
+import (
+	"github.com/louisun/allure-go-v2/allure"
+)
+
+// Known marks test result as a known issue
+func (a *allureManager) Known() {
+	a.safely(func(result *allure.Result) {
+		result.SetKnown(true)
+	})
+}
+
+// KnownIssue marks test result as a known issue and adds issue link due environment variable ALLURE_ISSUE_PATTERN
+func (a *allureManager) KnownIssue(issue string) {
+	a.Known()
+	a.SetIssue(issue)
+}
+
+// Muted marks test result as muted
+func (a *allureManager) Muted() {
+	a.safely(func(result *allure.Result) {
+		result.SetMuted(true)
+	})
+}
+
+// Flaky marks test result as flaky
+func (a *allureManager) Flaky() {
+	a.safely(func(result *allure.Result) {
+		result.SetFlaky(true)
+	})
+}
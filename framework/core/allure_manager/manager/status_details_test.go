@@ -0,0 +1,46 @@
+package manager
+
+import (
+	"testing"
+
+	"github.com/louisun/allure-go-v2/allure"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAllureManager_Known(t *testing.T) {
+	manager := allureManager{testMeta: &testMetaMockLinks{result: &allure.Result{}}}
+	manager.Known()
+	require.True(t, manager.GetResult().StatusDetails.Known)
+	require.False(t, manager.GetResult().StatusDetails.Muted)
+	require.False(t, manager.GetResult().StatusDetails.Flaky)
+}
+
+func TestAllureManager_KnownIssue(t *testing.T) {
+	manager := allureManager{testMeta: &testMetaMockLinks{result: &allure.Result{}}}
+	manager.KnownIssue("JIRA-1")
+	require.True(t, manager.GetResult().StatusDetails.Known)
+	require.Len(t, manager.GetResult().Links, 1)
+	require.Equal(t, "Issue[JIRA-1]", manager.GetResult().Links[0].Name)
+	require.Equal(t, string(allure.ISSUE), manager.GetResult().Links[0].Type)
+}
+
+func TestAllureManager_Muted(t *testing.T) {
+	manager := allureManager{testMeta: &testMetaMockLinks{result: &allure.Result{}}}
+	manager.Muted()
+	require.True(t, manager.GetResult().StatusDetails.Muted)
+}
+
+func TestAllureManager_Flaky(t *testing.T) {
+	manager := allureManager{testMeta: &testMetaMockLinks{result: &allure.Result{}}}
+	manager.Flaky()
+	require.True(t, manager.GetResult().StatusDetails.Flaky)
+}
+
+func TestAllureManager_StatusDetails_NilResult(t *testing.T) {
+	manager := allureManager{testMeta: &testMetaMockLinks{}}
+	require.NotPanics(t, func() {
+		manager.Known()
+		manager.Muted()
+		manager.Flaky()
+	})
+}
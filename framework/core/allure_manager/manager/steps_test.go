@@ -11,8 +11,8 @@ import (
 type testMetaMockSteps struct {
 	result    *allure.Result
 	container *allure.Container
-	be        func(t provider.T)
-	ae        func(t provider.T)
+	be        []provider.Hook
+	ae        []provider.Hook
 }
 
 func (m *testMetaMockSteps) GetResult() *allure.Result {
@@ -27,26 +27,26 @@ func (m *testMetaMockSteps) GetContainer() *allure.Container {
 	return m.container
 }
 
-func (m *testMetaMockSteps) SetBeforeEach(hook func(t provider.T)) {
-	m.be = hook
+func (m *testMetaMockSteps) SetBeforeEach(hooks ...provider.Hook) {
+	m.be = hooks
 }
 
-func (m *testMetaMockSteps) GetBeforeEach() func(t provider.T) {
+func (m *testMetaMockSteps) GetBeforeEach() []provider.Hook {
 	return m.be
 }
 
-func (m *testMetaMockSteps) SetAfterEach(hook func(t provider.T)) {
-	m.ae = hook
+func (m *testMetaMockSteps) SetAfterEach(hooks ...provider.Hook) {
+	m.ae = hooks
 }
 
-func (m *testMetaMockSteps) GetAfterEach() func(t provider.T) {
+func (m *testMetaMockSteps) GetAfterEach() []provider.Hook {
 	return m.ae
 }
 
 type suiteMetaMockSteps struct {
 	name      string
 	container *allure.Container
-	hook      func(t provider.T)
+	hook      []provider.Hook
 }
 
 func (m *suiteMetaMockSteps) GetPackageName() string {
@@ -73,19 +73,19 @@ func (m *suiteMetaMockSteps) GetContainer() *allure.Container {
 	return m.container
 }
 
-func (m *suiteMetaMockSteps) SetBeforeAll(hook func(provider.T)) {
-	m.hook = hook
+func (m *suiteMetaMockSteps) SetBeforeAll(hooks ...provider.Hook) {
+	m.hook = hooks
 }
 
-func (m *suiteMetaMockSteps) SetAfterAll(hook func(provider.T)) {
-	m.hook = hook
+func (m *suiteMetaMockSteps) SetAfterAll(hooks ...provider.Hook) {
+	m.hook = hooks
 }
 
-func (m *suiteMetaMockSteps) GetBeforeAll() func(provider.T) {
+func (m *suiteMetaMockSteps) GetBeforeAll() []provider.Hook {
 	return m.hook
 }
 
-func (m *suiteMetaMockSteps) GetAfterAll() func(provider.T) {
+func (m *suiteMetaMockSteps) GetAfterAll() []provider.Hook {
 	return m.hook
 }
 
@@ -0,0 +1,97 @@
+package rerun
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/louisun/allure-go-v2/allure"
+)
+
+var (
+	failedDir = flag.String("allure-go.rerun-failed", "", "allure-results directory of the previous run to rerun only its failed, broken and unknown tests")
+
+	once        sync.Once
+	failedTests *FailedTests
+)
+
+// FailedTests keeps tests that didn't pass in the previous run
+type FailedTests struct {
+	count       int
+	byHistoryID map[string]bool
+	byFullName  map[string]string // full name to HistoryID
+}
+
+// NewFailedTests collects tests of the results, which final attempt is failed, broken or unknown
+func NewFailedTests(results []*allure.Result) *FailedTests {
+	last := make(map[string]*allure.Result)
+	var order []string
+	for _, result := range results {
+		key := result.HistoryID
+		if key == "" {
+			key = result.FullName
+		}
+		if _, ok := last[key]; !ok {
+			order = append(order, key)
+		}
+		last[key] = result
+	}
+
+	failed := &FailedTests{byHistoryID: make(map[string]bool), byFullName: make(map[string]string)}
+	for _, key := range order {
+		result := last[key]
+		switch result.Status {
+		case allure.Failed, allure.Broken, allure.Unknown:
+		default:
+			continue
+		}
+		failed.count++
+		if result.HistoryID != "" {
+			failed.byHistoryID[result.HistoryID] = true
+		}
+		if result.FullName != "" {
+			failed.byFullName[result.FullName] = result.HistoryID
+		}
+	}
+	return failed
+}
+
+// GetFailedTests returns tests failed in the run set by -allure-go.rerun-failed flag.
+// Returns nil if the flag is not set.
+func GetFailedTests() *FailedTests {
+	once.Do(func() {
+		if *failedDir == "" {
+			return
+		}
+		results, err := allure.ReadResults(*failedDir)
+		if err != nil {
+			_, _ = fmt.Fprintf(os.Stderr, "allure-go: failed to read results for -allure-go.rerun-failed: %s\n", err)
+			os.Exit(1)
+		}
+		failedTests = NewFailedTests(results)
+		fmt.Printf("allure-go: %d failed tests found in %s. Only they will be run\n", failedTests.Len(), *failedDir)
+	})
+	return failedTests
+}
+
+// Len returns count of the failed tests
+func (f *FailedTests) Len() int {
+	return f.count
+}
+
+// Select returns true if the result is one of the failed tests. Tests are matched by HistoryID or FullName.
+// HistoryID of the previous run is set to the selected result, so Allure shows it as retry of the failed one.
+func (f *FailedTests) Select(result *allure.Result) bool {
+	if f.byHistoryID[result.HistoryID] {
+		return true
+	}
+	historyID, ok := f.byFullName[result.FullName]
+	if !ok {
+		return false
+	}
+	if historyID != "" {
+		result.HistoryID = historyID
+	}
+	return true
+}
@@ -0,0 +1,51 @@
+package rerun
+
+import (
+	"testing"
+
+	"github.com/louisun/allure-go-v2/allure"
+	"github.com/stretchr/testify/require"
+)
+
+func newResult(fullName string, status allure.Status, start int64) *allure.Result {
+	result := allure.NewResult(fullName, fullName)
+	result.Status = status
+	result.Start = start
+	return result
+}
+
+func TestNewFailedTests(t *testing.T) {
+	failed := NewFailedTests([]*allure.Result{
+		newResult("Suite/Passed", allure.Passed, 1),
+		newResult("Suite/Failed", allure.Failed, 2),
+		newResult("Suite/Broken", allure.Broken, 3),
+		newResult("Suite/Skipped", allure.Skipped, 4),
+		newResult("Suite/Flaky", allure.Failed, 5),
+		newResult("Suite/Flaky", allure.Passed, 6),
+	})
+	require.Equal(t, 2, failed.Len())
+
+	require.True(t, failed.Select(allure.NewResult("Failed", "Suite/Failed")))
+	require.True(t, failed.Select(allure.NewResult("Broken", "Suite/Broken")))
+	require.False(t, failed.Select(allure.NewResult("Passed", "Suite/Passed")))
+	require.False(t, failed.Select(allure.NewResult("Skipped", "Suite/Skipped")))
+	require.False(t, failed.Select(allure.NewResult("Flaky", "Suite/Flaky")))
+}
+
+func TestFailedTests_Select_keepsHistoryID(t *testing.T) {
+	previous := newResult("Suite/Failed", allure.Failed, 1)
+	previous.HistoryID = "previousHistoryID"
+	failed := NewFailedTests([]*allure.Result{previous})
+
+	byHistoryID := allure.NewResult("Renamed", "Suite/Renamed")
+	byHistoryID.HistoryID = "previousHistoryID"
+	require.True(t, failed.Select(byHistoryID))
+
+	byFullName := allure.NewResult("Failed", "Suite/Failed")
+	require.True(t, failed.Select(byFullName))
+	require.Equal(t, "previousHistoryID", byFullName.HistoryID)
+}
+
+func TestGetFailedTests_noFlag(t *testing.T) {
+	require.Nil(t, GetFailedTests())
+}
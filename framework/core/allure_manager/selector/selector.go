@@ -0,0 +1,369 @@
+package selector
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"unicode"
+
+	"github.com/louisun/allure-go-v2/allure"
+)
+
+var (
+	selectExpr    = flag.String("allure-go.select", "", "expression over labels to select tests of the allure-go suite, e.g. 'tag=smoke && !owner=legacy'")
+	selectSkipped = flag.Bool("allure-go.select-skipped", false, "report tests not selected by -allure-go.select as skipped instead of leaving them out of the report")
+)
+
+// Expression is a boolean expression over labels of the test result
+//
+// Grammar:
+//
+//	expr   := and ('||' and)*
+//	and    := unary ('&&' unary)*
+//	unary  := '!' unary | '(' expr ')' | term
+//	term   := name '=' value | name '!=' value | name 'in' '(' value (',' value)* ')' | name
+//
+// Term `name` matches if the result has label with the name.
+// Other terms match if any label with the name has matching value.
+// Values can be quoted with double quotes and may contain '*' wildcards.
+type Expression interface {
+	Match(labels []*allure.Label) bool
+	String() string
+}
+
+// Parse parses the expression of labels. Empty expression matches all tests
+func Parse(expr string) (Expression, error) {
+	tokens, err := tokenize(expr)
+	if err != nil {
+		return nil, err
+	}
+	if len(tokens) == 0 {
+		return matchAll{}, nil
+	}
+	p := &parser{tokens: tokens}
+	res, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if !p.done() {
+		return nil, fmt.Errorf("unexpected %q at position %d", p.peek().value, p.peek().pos)
+	}
+	return res, nil
+}
+
+// GetExpression returns parsed -allure-go.select expression.
+// Returns nil if the flag is not set.
+func GetExpression() Expression {
+	if *selectExpr == "" {
+		return nil
+	}
+	expr, err := Parse(*selectExpr)
+	if err != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "allure-go: invalid expression for -allure-go.select: %s\n", err)
+		os.Exit(1)
+	}
+	return expr
+}
+
+// ReportSkipped returns true if tests not selected by -allure-go.select are reported as skipped
+func ReportSkipped() bool {
+	return *selectSkipped
+}
+
+// SkipMessage returns status message of the test not selected by -allure-go.select
+func SkipMessage() string {
+	return fmt.Sprintf("Test is not selected by expression: %s", *selectExpr)
+}
+
+// MatchResult returns true if labels of the result match the expression
+func MatchResult(expr Expression, result *allure.Result) bool {
+	if expr == nil || result == nil {
+		return true
+	}
+	return expr.Match(result.Labels)
+}
+
+type matchAll struct{}
+
+func (matchAll) Match([]*allure.Label) bool { return true }
+func (matchAll) String() string             { return "" }
+
+type or struct{ left, right Expression }
+
+func (e or) Match(labels []*allure.Label) bool { return e.left.Match(labels) || e.right.Match(labels) }
+func (e or) String() string                    { return fmt.Sprintf("(%s || %s)", e.left, e.right) }
+
+type and struct{ left, right Expression }
+
+func (e and) Match(labels []*allure.Label) bool { return e.left.Match(labels) && e.right.Match(labels) }
+func (e and) String() string                    { return fmt.Sprintf("(%s && %s)", e.left, e.right) }
+
+type not struct{ expr Expression }
+
+func (e not) Match(labels []*allure.Label) bool { return !e.expr.Match(labels) }
+func (e not) String() string                    { return fmt.Sprintf("!%s", e.expr) }
+
+// has matches if the result has label with the name
+type has struct{ name string }
+
+func (e has) Match(labels []*allure.Label) bool {
+	for _, label := range labels {
+		if label.Name == e.name {
+			return true
+		}
+	}
+	return false
+}
+
+func (e has) String() string { return e.name }
+
+// in matches if any label with the name has one of the values
+type in struct {
+	name   string
+	values []string
+}
+
+func (e in) Match(labels []*allure.Label) bool {
+	for _, label := range labels {
+		if label.Name != e.name {
+			continue
+		}
+		for _, value := range e.values {
+			if matchWildcard(value, label.GetValue()) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (e in) String() string {
+	if len(e.values) == 1 {
+		return fmt.Sprintf("%s=%s", e.name, e.values[0])
+	}
+	return fmt.Sprintf("%s in (%s)", e.name, strings.Join(e.values, ","))
+}
+
+// matchWildcard matches value with the pattern, where '*' matches any sequence of characters
+func matchWildcard(pattern, value string) bool {
+	parts := strings.Split(pattern, "*")
+	if len(parts) == 1 {
+		return pattern == value
+	}
+	if !strings.HasPrefix(value, parts[0]) {
+		return false
+	}
+	value = value[len(parts[0]):]
+	for _, part := range parts[1 : len(parts)-1] {
+		idx := strings.Index(value, part)
+		if idx < 0 {
+			return false
+		}
+		value = value[idx+len(part):]
+	}
+	return strings.HasSuffix(value, parts[len(parts)-1])
+}
+
+type tokenKind int
+
+const (
+	tokenWord tokenKind = iota
+	tokenOp
+)
+
+type token struct {
+	kind  tokenKind
+	value string
+	pos   int
+}
+
+var operators = []string{"&&", "||", "!=", "=", "!", "(", ")", ","}
+
+func tokenize(expr string) ([]token, error) {
+	var tokens []token
+	for i := 0; i < len(expr); {
+		r := rune(expr[i])
+		if unicode.IsSpace(r) {
+			i++
+			continue
+		}
+		if r == '"' {
+			end := strings.IndexByte(expr[i+1:], '"')
+			if end < 0 {
+				return nil, fmt.Errorf("unterminated quote at position %d", i)
+			}
+			tokens = append(tokens, token{kind: tokenWord, value: expr[i+1 : i+1+end], pos: i})
+			i += end + 2
+			continue
+		}
+		if op, ok := operatorAt(expr, i); ok {
+			tokens = append(tokens, token{kind: tokenOp, value: op, pos: i})
+			i += len(op)
+			continue
+		}
+		start := i
+		for i < len(expr) && !unicode.IsSpace(rune(expr[i])) && expr[i] != '"' {
+			if _, ok := operatorAt(expr, i); ok {
+				break
+			}
+			i++
+		}
+		tokens = append(tokens, token{kind: tokenWord, value: expr[start:i], pos: start})
+	}
+	return tokens, nil
+}
+
+func operatorAt(expr string, i int) (string, bool) {
+	for _, op := range operators {
+		if strings.HasPrefix(expr[i:], op) {
+			return op, true
+		}
+	}
+	return "", false
+}
+
+type parser struct {
+	tokens []token
+	pos    int
+}
+
+func (p *parser) done() bool {
+	return p.pos >= len(p.tokens)
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.pos]
+}
+
+func (p *parser) isOp(op string) bool {
+	return !p.done() && p.peek().kind == tokenOp && p.peek().value == op
+}
+
+func (p *parser) expectOp(op string) error {
+	if !p.isOp(op) {
+		return p.unexpected(fmt.Sprintf("%q", op))
+	}
+	p.pos++
+	return nil
+}
+
+func (p *parser) word() (string, error) {
+	if p.done() || p.peek().kind != tokenWord {
+		return "", p.unexpected("label name or value")
+	}
+	value := p.peek().value
+	p.pos++
+	return value, nil
+}
+
+func (p *parser) unexpected(expected string) error {
+	if p.done() {
+		return fmt.Errorf("unexpected end of expression, expected %s", expected)
+	}
+	return fmt.Errorf("unexpected %q at position %d, expected %s", p.peek().value, p.peek().pos, expected)
+}
+
+func (p *parser) parseOr() (Expression, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.isOp("||") {
+		p.pos++
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = or{left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *parser) parseAnd() (Expression, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for p.isOp("&&") {
+		p.pos++
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		left = and{left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *parser) parseUnary() (Expression, error) {
+	switch {
+	case p.isOp("!"):
+		p.pos++
+		expr, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return not{expr: expr}, nil
+	case p.isOp("("):
+		p.pos++
+		expr, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if err = p.expectOp(")"); err != nil {
+			return nil, err
+		}
+		return expr, nil
+	}
+	return p.parseTerm()
+}
+
+func (p *parser) parseTerm() (Expression, error) {
+	name, err := p.word()
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case p.isOp("="):
+		p.pos++
+		value, err := p.word()
+		if err != nil {
+			return nil, err
+		}
+		return in{name: name, values: []string{value}}, nil
+	case p.isOp("!="):
+		p.pos++
+		value, err := p.word()
+		if err != nil {
+			return nil, err
+		}
+		return not{expr: in{name: name, values: []string{value}}}, nil
+	case !p.done() && p.peek().kind == tokenWord && p.peek().value == "in":
+		p.pos++
+		return p.parseIn(name)
+	}
+	return has{name: name}, nil
+}
+
+func (p *parser) parseIn(name string) (Expression, error) {
+	if err := p.expectOp("("); err != nil {
+		return nil, err
+	}
+	var values []string
+	for {
+		value, err := p.word()
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, value)
+		if !p.isOp(",") {
+			break
+		}
+		p.pos++
+	}
+	if err := p.expectOp(")"); err != nil {
+		return nil, err
+	}
+	return in{name: name, values: values}, nil
+}
@@ -0,0 +1,92 @@
+package selector
+
+import (
+	"testing"
+
+	"github.com/louisun/allure-go-v2/allure"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse_Match(t *testing.T) {
+	labels := []*allure.Label{
+		allure.TagLabel("smoke"),
+		allure.TagLabel("api"),
+		allure.SeverityLabel(allure.CRITICAL),
+		allure.OwnerLabel("team-a"),
+		allure.SuiteLabel("Payments Suite"),
+	}
+
+	tests := []struct {
+		expr  string
+		match bool
+	}{
+		{"", true},
+		{"tag=smoke", true},
+		{"tag=regression", false},
+		{"tag=api && tag=smoke", true},
+		{"tag=smoke && severity in (critical,blocker) && !owner=legacy", true},
+		{"tag=smoke && severity in (minor, trivial)", false},
+		{"!owner=team-a", false},
+		{"owner!=team-a", false},
+		{"owner != legacy", true},
+		{"tag=nightly || tag=smoke", true},
+		{"tag=nightly || (tag=smoke && owner=legacy)", false},
+		{"!(tag=nightly || owner=legacy)", true},
+		{"owner", true},
+		{"lead", false},
+		{"owner=team-*", true},
+		{"owner=*-b", false},
+		{`suite="Payments Suite"`, true},
+		{`suite in ("Payments*", Other)`, true},
+	}
+	for _, test := range tests {
+		t.Run(test.expr, func(t *testing.T) {
+			expr, err := Parse(test.expr)
+			require.NoError(t, err)
+			require.Equal(t, test.match, expr.Match(labels))
+		})
+	}
+}
+
+func TestParse_Errors(t *testing.T) {
+	for _, expr := range []string{
+		"tag=",
+		"tag=smoke &&",
+		"(tag=smoke",
+		"tag=smoke)",
+		"severity in critical",
+		"severity in (critical",
+		`tag="smoke`,
+		"&& tag=smoke",
+		"tag=smoke tag=api",
+	} {
+		t.Run(expr, func(t *testing.T) {
+			_, err := Parse(expr)
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestMatchResult(t *testing.T) {
+	result := allure.NewResult("name", "fullName")
+	result.AddLabel(allure.TagLabel("smoke"))
+
+	expr, err := Parse("tag=smoke")
+	require.NoError(t, err)
+	require.True(t, MatchResult(expr, result))
+	require.True(t, MatchResult(nil, result))
+
+	expr, err = Parse("!tag=smoke")
+	require.NoError(t, err)
+	require.False(t, MatchResult(expr, result))
+}
+
+func TestMatchWildcard(t *testing.T) {
+	require.True(t, matchWildcard("abc", "abc"))
+	require.False(t, matchWildcard("abc", "abcd"))
+	require.True(t, matchWildcard("a*", "abcd"))
+	require.True(t, matchWildcard("*d", "abcd"))
+	require.True(t, matchWildcard("a*c*", "abcd"))
+	require.True(t, matchWildcard("*", ""))
+	require.False(t, matchWildcard("a*c*e", "abcd"))
+}
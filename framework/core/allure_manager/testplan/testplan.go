@@ -3,11 +3,16 @@ package testplan
 import (
 	"encoding/json"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"os"
+	"path"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"sync"
+
+	"github.com/louisun/allure-go-v2/allure"
 )
 
 var (
@@ -23,9 +28,42 @@ type TestCase struct {
 	Selector string `json:"selector"`
 }
 
+// UnmarshalJSON accepts id of the test case both as number and as string
+func (tc *TestCase) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		ID       json.Number `json:"id"`
+		Selector string      `json:"selector"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	tc.Selector = raw.Selector
+	if raw.ID == "" {
+		tc.ID = 0
+		return nil
+	}
+	id, err := strconv.Atoi(raw.ID.String())
+	if err != nil {
+		return fmt.Errorf("invalid id of the test case %q: %w", raw.Selector, err)
+	}
+	tc.ID = id
+	return nil
+}
+
+// String returns description of the test case for logs
+func (tc *TestCase) String() string {
+	if tc.ID == 0 {
+		return fmt.Sprintf("selector=%s", tc.Selector)
+	}
+	return fmt.Sprintf("id=%d selector=%s", tc.ID, tc.Selector)
+}
+
 type TestPlan struct {
 	Version string      `json:"version"`
 	Tests   []*TestCase `json:"tests"`
+
+	mu      sync.Mutex
+	matched map[*TestCase]bool
 }
 
 func newTestPlan() (*TestPlan, error) {
@@ -54,17 +92,82 @@ func newTestPlan() (*TestPlan, error) {
 	return plan, nil
 }
 
-// IsSelected returns true if selector matches with testplan selector
-// TODO: ID parsing from TestOps
+// IsSelected returns true if id matches with id of the testplan entry or, if the entry has no id,
+// selector matches with testplan selector. Testplan selectors can be glob patterns (see path.Match).
+// id is the value of ALLURE_ID label of the test.
 func (p *TestPlan) IsSelected(id, selector string) bool {
+	selected := false
+	for _, t := range p.Tests {
+		if t.matches(id, selector) {
+			p.markMatched(t)
+			selected = true
+		}
+	}
+	return selected
+}
+
+// IsResultSelected returns true if ALLURE_ID label or full name of the result matches with the testplan
+func (p *TestPlan) IsResultSelected(result *allure.Result) bool {
+	var id string
+	if label, ok := result.GetFirstLabel(allure.AllureID); ok {
+		id = label.GetValue()
+	}
+	return p.IsSelected(id, result.FullName)
+}
+
+// Unmatched returns testplan entries that matched no test so far
+func (p *TestPlan) Unmatched() []*TestCase {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	var res []*TestCase
 	for _, t := range p.Tests {
-		if t.Selector == selector {
-			return true
+		if !p.matched[t] {
+			res = append(res, t)
 		}
 	}
+	return res
+}
+
+// WarnUnmatched writes the list of testplan entries that matched no test to w.
+// Returns false if there are such entries.
+func (p *TestPlan) WarnUnmatched(w io.Writer) bool {
+	unmatched := p.Unmatched()
+	if len(unmatched) == 0 {
+		return true
+	}
+	_, _ = fmt.Fprintf(w, "allure-go: %d of %d testplan entries matched no test:\n", len(unmatched), len(p.Tests))
+	for _, t := range unmatched {
+		_, _ = fmt.Fprintf(w, "\t%s\n", t)
+	}
 	return false
 }
 
+func (p *TestPlan) markMatched(t *TestCase) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.matched == nil {
+		p.matched = make(map[*TestCase]bool)
+	}
+	p.matched[t] = true
+}
+
+// matches returns true if the test case is selected by id or, if it has no id, by selector
+func (tc *TestCase) matches(id, selector string) bool {
+	if tc.ID != 0 && id != "" {
+		return strconv.Itoa(tc.ID) == id
+	}
+	if tc.Selector == "" {
+		return false
+	}
+	if tc.Selector == selector {
+		return true
+	}
+	ok, err := path.Match(tc.Selector, selector)
+	return err == nil && ok
+}
+
 func initTestPlan() *TestPlan {
 	var (
 		err   error
@@ -0,0 +1,82 @@
+package testplan
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/louisun/allure-go-v2/allure"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTestCase_UnmarshalJSON(t *testing.T) {
+	plan := &TestPlan{}
+	err := json.Unmarshal([]byte(`{"version":"1.0","tests":[
+		{"id": 1, "selector": "TestA"},
+		{"id": "2", "selector": "TestB"},
+		{"selector": "TestC"}
+	]}`), plan)
+	require.NoError(t, err)
+	require.Len(t, plan.Tests, 3)
+	require.Equal(t, 1, plan.Tests[0].ID)
+	require.Equal(t, 2, plan.Tests[1].ID)
+	require.Equal(t, "TestB", plan.Tests[1].Selector)
+	require.Equal(t, 0, plan.Tests[2].ID)
+
+	err = json.Unmarshal([]byte(`{"tests":[{"id": "abc", "selector": "TestA"}]}`), plan)
+	require.Error(t, err)
+}
+
+func TestTestPlan_IsSelected(t *testing.T) {
+	plan := &TestPlan{Tests: []*TestCase{
+		{ID: 10, Selector: "TestSuite/Suite/TestA"},
+		{Selector: "TestSuite/Suite/TestB"},
+		{Selector: "TestGlob/*/TestC*"},
+	}}
+
+	// by ALLURE_ID
+	require.True(t, plan.IsSelected("10", "TestSuite/Suite/Renamed"))
+	require.False(t, plan.IsSelected("11", "TestSuite/Suite/TestA"))
+	// selector fallback for tests without ALLURE_ID
+	require.True(t, plan.IsSelected("", "TestSuite/Suite/TestA"))
+	require.True(t, plan.IsSelected("12", "TestSuite/Suite/TestB"))
+	// glob selectors
+	require.True(t, plan.IsSelected("", "TestGlob/Suite/TestCase1"))
+	require.False(t, plan.IsSelected("", "TestGlob/Suite/Nested/TestCase1"))
+	require.False(t, plan.IsSelected("", "TestSuite/Suite/TestD"))
+}
+
+func TestTestPlan_IsResultSelected(t *testing.T) {
+	plan := &TestPlan{Tests: []*TestCase{{ID: 10, Selector: "TestA"}}}
+
+	result := allure.NewResult("name", "Other")
+	result.AddLabel(allure.IDAllureLabel("10"))
+	require.True(t, plan.IsResultSelected(result))
+
+	result = allure.NewResult("name", "Other")
+	require.False(t, plan.IsResultSelected(result))
+
+	result = allure.NewResult("name", "TestA")
+	require.True(t, plan.IsResultSelected(result))
+}
+
+func TestTestPlan_WarnUnmatched(t *testing.T) {
+	plan := &TestPlan{Tests: []*TestCase{
+		{ID: 10, Selector: "TestA"},
+		{Selector: "TestB"},
+		{ID: 12, Selector: "TestC"},
+	}}
+	plan.IsSelected("10", "TestA")
+
+	require.Equal(t, []*TestCase{plan.Tests[1], plan.Tests[2]}, plan.Unmatched())
+
+	buf := &bytes.Buffer{}
+	require.False(t, plan.WarnUnmatched(buf))
+	require.Equal(t, "allure-go: 2 of 3 testplan entries matched no test:\n\tselector=TestB\n\tid=12 selector=TestC\n", buf.String())
+
+	plan.IsSelected("", "TestB")
+	plan.IsSelected("12", "")
+	buf.Reset()
+	require.True(t, plan.WarnUnmatched(buf))
+	require.Empty(t, buf.String())
+}
@@ -0,0 +1,180 @@
+package assert
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// ExtractJSONPath returns value of the JSON document at the path.
+// Path supports dot and bracket notations of object keys and array indexes, e.g. `$.items[0].id` or `$['items'][-1]`.
+// Negative index counts from the end of the array.
+func ExtractJSONPath(document string, path string) (interface{}, error) {
+	var value interface{}
+	if err := json.Unmarshal([]byte(document), &value); err != nil {
+		return nil, fmt.Errorf("JSON parsing error: %s", err)
+	}
+	return extractJSONPath(value, path)
+}
+
+// JSONPathEqual asserts that value of the JSON document at the path is equal to expected.
+// Expected value is compared as it's encoded to JSON, so 42 is equal to 42.0 in the document.
+//
+//	assert.JSONPathEqual(t, `{"items": [{"id": 42}]}`, "$.items[0].id", 42)
+func JSONPathEqual(t TestingT, actual string, path string, expected interface{}, msgAndArgs ...interface{}) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	value, err := ExtractJSONPath(actual, path)
+	if err != nil {
+		return assert.Fail(t, fmt.Sprintf("Path %s of JSON ('%s') can't be extracted: %s", path, actual, err), msgAndArgs...)
+	}
+	expectedValue, err := normalizeJSON(expected)
+	if err != nil {
+		return assert.Fail(t, fmt.Sprintf("Expected value (%#v) can't be encoded to JSON: %s", expected, err), msgAndArgs...)
+	}
+	if !reflect.DeepEqual(expectedValue, value) {
+		return assert.Fail(t, fmt.Sprintf("Value at path %s is not equal:\nexpected: %s\nactual  : %s", path, FormatJSONValue(expectedValue), FormatJSONValue(value)), msgAndArgs...)
+	}
+	return true
+}
+
+// JSONPathExists asserts that JSON document has value at the path.
+//
+//	assert.JSONPathExists(t, `{"items": [{"id": 42}]}`, "$.items[0].id")
+func JSONPathExists(t TestingT, actual string, path string, msgAndArgs ...interface{}) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	if _, err := ExtractJSONPath(actual, path); err != nil {
+		return assert.Fail(t, fmt.Sprintf("Path %s doesn't exist in JSON ('%s'): %s", path, actual, err), msgAndArgs...)
+	}
+	return true
+}
+
+// JSONPathLen asserts that array, object or string at the path of the JSON document has specific length.
+//
+//	assert.JSONPathLen(t, `{"items": [1, 2, 3]}`, "$.items", 3)
+func JSONPathLen(t TestingT, actual string, path string, length int, msgAndArgs ...interface{}) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	value, err := ExtractJSONPath(actual, path)
+	if err != nil {
+		return assert.Fail(t, fmt.Sprintf("Path %s of JSON ('%s') can't be extracted: %s", path, actual, err), msgAndArgs...)
+	}
+	var l int
+	switch v := value.(type) {
+	case []interface{}:
+		l = len(v)
+	case map[string]interface{}:
+		l = len(v)
+	case string:
+		l = len([]rune(v))
+	default:
+		return assert.Fail(t, fmt.Sprintf("Value at path %s (%s) has no length", path, FormatJSONValue(value)), msgAndArgs...)
+	}
+	if l != length {
+		return assert.Fail(t, fmt.Sprintf("Value at path %s should have %d item(s), but has %d", path, length, l), msgAndArgs...)
+	}
+	return true
+}
+
+// FormatJSONValue encodes value extracted from JSON document back to JSON
+func FormatJSONValue(value interface{}) string {
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+	return string(encoded)
+}
+
+// normalizeJSON converts value to the form produced by decoding its JSON
+func normalizeJSON(value interface{}) (interface{}, error) {
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	var normalized interface{}
+	err = json.Unmarshal(encoded, &normalized)
+	return normalized, err
+}
+
+func extractJSONPath(value interface{}, path string) (interface{}, error) {
+	if !strings.HasPrefix(path, "$") {
+		return nil, fmt.Errorf("path %q should start with $", path)
+	}
+	rest := path[1:]
+	current := "$"
+	for rest != "" {
+		var (
+			key   string
+			index int
+			isKey bool
+			err   error
+		)
+		switch rest[0] {
+		case '.':
+			end := strings.IndexAny(rest[1:], ".[")
+			if end < 0 {
+				end = len(rest) - 1
+			}
+			key, isKey, rest = rest[1:end+1], true, rest[end+1:]
+			if key == "" {
+				return nil, fmt.Errorf("empty key after %s", current)
+			}
+		case '[':
+			end := strings.IndexByte(rest, ']')
+			if end < 0 {
+				return nil, fmt.Errorf("unclosed bracket after %s", current)
+			}
+			selector := rest[1:end]
+			rest = rest[end+1:]
+			if len(selector) >= 2 && (selector[0] == '\'' || selector[0] == '"') && selector[len(selector)-1] == selector[0] {
+				key, isKey = selector[1:len(selector)-1], true
+			} else if index, err = strconv.Atoi(selector); err != nil {
+				return nil, fmt.Errorf("unsupported selector [%s] after %s", selector, current)
+			}
+		default:
+			return nil, fmt.Errorf("unexpected %q after %s", rest[0], current)
+		}
+
+		if isKey {
+			object, ok := value.(map[string]interface{})
+			if !ok {
+				return nil, fmt.Errorf("%s is not an object", current)
+			}
+			if value, ok = object[key]; !ok {
+				return nil, fmt.Errorf("%s has no key %q", current, key)
+			}
+			current = jsonPathKey(current, key)
+			continue
+		}
+		array, ok := value.([]interface{})
+		if !ok {
+			return nil, fmt.Errorf("%s is not an array", current)
+		}
+		i := index
+		if i < 0 {
+			i += len(array)
+		}
+		if i < 0 || i >= len(array) {
+			return nil, fmt.Errorf("index %d is out of range of %s with length %d", index, current, len(array))
+		}
+		value = array[i]
+		current = fmt.Sprintf("%s[%d]", current, index)
+	}
+	return value, nil
+}
+
+// jsonPathKey appends key to the path in dot notation if it's possible
+func jsonPathKey(path, key string) string {
+	if key == "" || strings.ContainsAny(key, ".[]'\" ") {
+		return fmt.Sprintf("%s[%q]", path, key)
+	}
+	return path + "." + key
+}
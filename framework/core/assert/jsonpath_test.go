@@ -0,0 +1,74 @@
+package assert
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const jsonPathDocument = `{"items": [{"id": 42, "name": "first"}, {"id": 43, "tags": []}], "total": 2, "odd key": {"a.b": true}}`
+
+func TestExtractJSONPath(t *testing.T) {
+	for path, expected := range map[string]interface{}{
+		"$":                   nil,
+		"$.total":             float64(2),
+		"$.items[0].id":       float64(42),
+		"$['items'][1]['id']": float64(43),
+		`$.items[-1].tags`:    []interface{}{},
+		`$["odd key"]["a.b"]`: true,
+	} {
+		value, err := ExtractJSONPath(jsonPathDocument, path)
+		assert.NoError(t, err, path)
+		if path != "$" {
+			assert.Equal(t, expected, value, path)
+		}
+	}
+}
+
+func TestExtractJSONPath_Errors(t *testing.T) {
+	for path, message := range map[string]string{
+		"items":          `path "items" should start with $`,
+		"$.missing":      `$ has no key "missing"`,
+		"$.items[2]":     "index 2 is out of range of $.items with length 2",
+		"$.total.value":  "$.total is not an object",
+		"$.items.id":     "$.items is not an object",
+		"$.total[0]":     "$.total is not an array",
+		"$.items[*]":     "unsupported selector [*] after $.items",
+		"$.items[0":      "unclosed bracket after $.items",
+		"$..items":       "empty key after $",
+		"$.items[0]id":   `unexpected 'i' after $.items[0]`,
+		`$["odd key"].x`: `$["odd key"] has no key "x"`,
+	} {
+		_, err := ExtractJSONPath(jsonPathDocument, path)
+		assert.EqualError(t, err, message, path)
+	}
+
+	_, err := ExtractJSONPath(`{`, "$")
+	assert.Error(t, err)
+}
+
+func TestJSONPathEqual(t *testing.T) {
+	mockT := new(testing.T)
+	assert.True(t, JSONPathEqual(mockT, jsonPathDocument, "$.items[0].id", 42))
+	assert.True(t, JSONPathEqual(mockT, jsonPathDocument, "$.items[0].name", "first"))
+	assert.True(t, JSONPathEqual(mockT, jsonPathDocument, "$.items[0]", map[string]interface{}{"id": 42, "name": "first"}))
+	assert.False(t, JSONPathEqual(mockT, jsonPathDocument, "$.items[0].id", 43))
+	assert.False(t, JSONPathEqual(mockT, jsonPathDocument, "$.items[0].id", "42"))
+	assert.False(t, JSONPathEqual(mockT, jsonPathDocument, "$.missing", 42))
+}
+
+func TestJSONPathExists(t *testing.T) {
+	mockT := new(testing.T)
+	assert.True(t, JSONPathExists(mockT, jsonPathDocument, "$.items[1].tags"))
+	assert.False(t, JSONPathExists(mockT, jsonPathDocument, "$.items[1].name"))
+}
+
+func TestJSONPathLen(t *testing.T) {
+	mockT := new(testing.T)
+	assert.True(t, JSONPathLen(mockT, jsonPathDocument, "$.items", 2))
+	assert.True(t, JSONPathLen(mockT, jsonPathDocument, "$.items[0]", 2))
+	assert.True(t, JSONPathLen(mockT, jsonPathDocument, "$.items[0].name", 5))
+	assert.False(t, JSONPathLen(mockT, jsonPathDocument, "$.items", 3))
+	assert.False(t, JSONPathLen(mockT, jsonPathDocument, "$.total", 2))
+	assert.False(t, JSONPathLen(mockT, jsonPathDocument, "$.missing", 2))
+}
@@ -0,0 +1,354 @@
+package assert
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// SchemaViolation describes the place of the JSON document, which doesn't match the schema
+type SchemaViolation struct {
+	Path    string `json:"path"`
+	Message string `json:"message"`
+}
+
+// ValidateJSONSchema returns places of the JSON document, which don't match the JSON schema.
+// Supported keywords: type, enum, const, properties, required, additionalProperties, minProperties, maxProperties,
+// items, minItems, maxItems, uniqueItems, minLength, maxLength, pattern, minimum, maximum, exclusiveMinimum,
+// exclusiveMaximum, multipleOf, allOf, anyOf, oneOf, not and local $ref (e.g. "#/definitions/item").
+// Other keywords are ignored.
+func ValidateJSONSchema(schema string, document string) ([]SchemaViolation, error) {
+	var schemaValue, value interface{}
+	if err := json.Unmarshal([]byte(schema), &schemaValue); err != nil {
+		return nil, fmt.Errorf("schema parsing error: %s", err)
+	}
+	if err := json.Unmarshal([]byte(document), &value); err != nil {
+		return nil, fmt.Errorf("JSON parsing error: %s", err)
+	}
+	v := &schemaValidator{root: schemaValue}
+	v.validate(schemaValue, value, "$")
+	return v.violations, v.err
+}
+
+// MatchesJSONSchema asserts that JSON document matches the JSON schema. See ValidateJSONSchema for supported keywords.
+//
+//	assert.MatchesJSONSchema(t, `{"type": "object", "required": ["id"]}`, `{"id": 42}`)
+func MatchesJSONSchema(t TestingT, schema string, actual string, msgAndArgs ...interface{}) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	violations, err := ValidateJSONSchema(schema, actual)
+	return NoSchemaViolations(t, actual, violations, err, msgAndArgs...)
+}
+
+// NoSchemaViolations asserts that ValidateJSONSchema found no violations in JSON document.
+// It lets the document validated once be reported without validating it again.
+func NoSchemaViolations(t TestingT, actual string, violations []SchemaViolation, err error, msgAndArgs ...interface{}) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	if err != nil {
+		return assert.Fail(t, fmt.Sprintf("JSON ('%s') can't be validated: %s", actual, err), msgAndArgs...)
+	}
+	if len(violations) == 0 {
+		return true
+	}
+	lines := make([]string, 0, len(violations))
+	for _, violation := range violations {
+		lines = append(lines, fmt.Sprintf("%s: %s", violation.Path, violation.Message))
+	}
+	return assert.Fail(t, fmt.Sprintf("JSON doesn't match the schema:\n%s", strings.Join(lines, "\n")), msgAndArgs...)
+}
+
+type schemaValidator struct {
+	root       interface{}
+	violations []SchemaViolation
+	err        error
+}
+
+func (v *schemaValidator) violate(path string, format string, args ...interface{}) {
+	v.violations = append(v.violations, SchemaViolation{Path: path, Message: fmt.Sprintf(format, args...)})
+}
+
+// matches checks the value against the schema without keeping violations
+func (v *schemaValidator) matches(schema interface{}, value interface{}, path string) bool {
+	sub := &schemaValidator{root: v.root}
+	sub.validate(schema, value, path)
+	if sub.err != nil && v.err == nil {
+		v.err = sub.err
+	}
+	return len(sub.violations) == 0
+}
+
+func (v *schemaValidator) validate(schema interface{}, value interface{}, path string) {
+	switch s := schema.(type) {
+	case bool:
+		if !s {
+			v.violate(path, "no value is allowed")
+		}
+		return
+	case map[string]interface{}:
+		if ref, ok := s["$ref"].(string); ok {
+			resolved, err := v.resolve(ref)
+			if err != nil {
+				v.err = err
+				return
+			}
+			v.validate(resolved, value, path)
+			return
+		}
+		v.validateGeneric(s, value, path)
+		switch val := value.(type) {
+		case map[string]interface{}:
+			v.validateObject(s, val, path)
+		case []interface{}:
+			v.validateArray(s, val, path)
+		case string:
+			v.validateString(s, val, path)
+		case float64:
+			v.validateNumber(s, val, path)
+		}
+	default:
+		v.err = fmt.Errorf("schema at %s should be an object or a boolean", path)
+	}
+}
+
+func (v *schemaValidator) resolve(ref string) (interface{}, error) {
+	if !strings.HasPrefix(ref, "#") {
+		return nil, fmt.Errorf("only local $ref is supported, got %q", ref)
+	}
+	current := v.root
+	for _, token := range strings.Split(strings.TrimPrefix(ref, "#"), "/") {
+		if token == "" {
+			continue
+		}
+		token = strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")
+		object, ok := current.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("$ref %q can't be resolved", ref)
+		}
+		if current, ok = object[token]; !ok {
+			return nil, fmt.Errorf("$ref %q can't be resolved", ref)
+		}
+	}
+	return current, nil
+}
+
+func (v *schemaValidator) validateGeneric(schema map[string]interface{}, value interface{}, path string) {
+	if t, ok := schema["type"]; ok && !matchesType(t, value) {
+		v.violate(path, "expected type %s, got %s", formatSchemaType(t), jsonType(value))
+	}
+	if enum, ok := schema["enum"].([]interface{}); ok {
+		found := false
+		for _, e := range enum {
+			if reflect.DeepEqual(e, value) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			v.violate(path, "value %s is not one of %s", FormatJSONValue(value), FormatJSONValue(enum))
+		}
+	}
+	if c, ok := schema["const"]; ok && !reflect.DeepEqual(c, value) {
+		v.violate(path, "value %s is not equal to %s", FormatJSONValue(value), FormatJSONValue(c))
+	}
+	if allOf, ok := schema["allOf"].([]interface{}); ok {
+		for _, sub := range allOf {
+			v.validate(sub, value, path)
+		}
+	}
+	if anyOf, ok := schema["anyOf"].([]interface{}); ok {
+		matched := false
+		for _, sub := range anyOf {
+			if v.matches(sub, value, path) {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			v.violate(path, "value doesn't match any schema of anyOf")
+		}
+	}
+	if oneOf, ok := schema["oneOf"].([]interface{}); ok {
+		matched := 0
+		for _, sub := range oneOf {
+			if v.matches(sub, value, path) {
+				matched++
+			}
+		}
+		if matched != 1 {
+			v.violate(path, "value matches %d schemas of oneOf instead of one", matched)
+		}
+	}
+	if not, ok := schema["not"]; ok && v.matches(not, value, path) {
+		v.violate(path, "value matches schema of not")
+	}
+}
+
+func (v *schemaValidator) validateObject(schema map[string]interface{}, object map[string]interface{}, path string) {
+	if required, ok := schema["required"].([]interface{}); ok {
+		for _, r := range required {
+			if key, ok := r.(string); ok {
+				if _, ok := object[key]; !ok {
+					v.violate(path, "required property %q is missing", key)
+				}
+			}
+		}
+	}
+	if min, ok := schema["minProperties"].(float64); ok && float64(len(object)) < min {
+		v.violate(path, "expected at least %v properties, got %d", min, len(object))
+	}
+	if max, ok := schema["maxProperties"].(float64); ok && float64(len(object)) > max {
+		v.violate(path, "expected at most %v properties, got %d", max, len(object))
+	}
+
+	properties, _ := schema["properties"].(map[string]interface{})
+	additional, hasAdditional := schema["additionalProperties"]
+	keys := make([]string, 0, len(object))
+	for key := range object {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		if sub, ok := properties[key]; ok {
+			v.validate(sub, object[key], jsonPathKey(path, key))
+			continue
+		}
+		if !hasAdditional {
+			continue
+		}
+		if allowed, ok := additional.(bool); ok && !allowed {
+			v.violate(jsonPathKey(path, key), "additional property is not allowed")
+			continue
+		}
+		v.validate(additional, object[key], jsonPathKey(path, key))
+	}
+}
+
+func (v *schemaValidator) validateArray(schema map[string]interface{}, array []interface{}, path string) {
+	if min, ok := schema["minItems"].(float64); ok && float64(len(array)) < min {
+		v.violate(path, "expected at least %v items, got %d", min, len(array))
+	}
+	if max, ok := schema["maxItems"].(float64); ok && float64(len(array)) > max {
+		v.violate(path, "expected at most %v items, got %d", max, len(array))
+	}
+	if unique, ok := schema["uniqueItems"].(bool); ok && unique {
+		for i := range array {
+			for j := i + 1; j < len(array); j++ {
+				if reflect.DeepEqual(array[i], array[j]) {
+					v.violate(path, "items %d and %d are equal", i, j)
+				}
+			}
+		}
+	}
+	switch items := schema["items"].(type) {
+	case map[string]interface{}, bool:
+		for i, item := range array {
+			v.validate(items, item, fmt.Sprintf("%s[%d]", path, i))
+		}
+	case []interface{}:
+		for i, item := range array {
+			if i < len(items) {
+				v.validate(items[i], item, fmt.Sprintf("%s[%d]", path, i))
+			}
+		}
+	}
+}
+
+func (v *schemaValidator) validateString(schema map[string]interface{}, str string, path string) {
+	length := float64(len([]rune(str)))
+	if min, ok := schema["minLength"].(float64); ok && length < min {
+		v.violate(path, "expected at least %v characters, got %v", min, length)
+	}
+	if max, ok := schema["maxLength"].(float64); ok && length > max {
+		v.violate(path, "expected at most %v characters, got %v", max, length)
+	}
+	if pattern, ok := schema["pattern"].(string); ok {
+		rx, err := regexp.Compile(pattern)
+		if err != nil {
+			v.err = fmt.Errorf("invalid pattern %q at %s: %s", pattern, path, err)
+			return
+		}
+		if !rx.MatchString(str) {
+			v.violate(path, "value %q doesn't match pattern %q", str, pattern)
+		}
+	}
+}
+
+func (v *schemaValidator) validateNumber(schema map[string]interface{}, number float64, path string) {
+	if min, ok := schema["minimum"].(float64); ok && number < min {
+		v.violate(path, "value %v is less than minimum %v", number, min)
+	}
+	if max, ok := schema["maximum"].(float64); ok && number > max {
+		v.violate(path, "value %v is greater than maximum %v", number, max)
+	}
+	if min, ok := schema["exclusiveMinimum"].(float64); ok && number <= min {
+		v.violate(path, "value %v is not greater than exclusive minimum %v", number, min)
+	}
+	if max, ok := schema["exclusiveMaximum"].(float64); ok && number >= max {
+		v.violate(path, "value %v is not less than exclusive maximum %v", number, max)
+	}
+	if multipleOf, ok := schema["multipleOf"].(float64); ok && multipleOf > 0 {
+		if quotient := number / multipleOf; quotient != math.Trunc(quotient) {
+			v.violate(path, "value %v is not a multiple of %v", number, multipleOf)
+		}
+	}
+}
+
+func matchesType(schemaType interface{}, value interface{}) bool {
+	switch t := schemaType.(type) {
+	case string:
+		return matchesTypeName(t, value)
+	case []interface{}:
+		for _, name := range t {
+			if n, ok := name.(string); ok && matchesTypeName(n, value) {
+				return true
+			}
+		}
+		return false
+	}
+	return true
+}
+
+func matchesTypeName(name string, value interface{}) bool {
+	actual := jsonType(value)
+	if name == "number" && actual == "integer" {
+		return true
+	}
+	return name == actual
+}
+
+func jsonType(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case float64:
+		if v == math.Trunc(v) {
+			return "integer"
+		}
+		return "number"
+	case string:
+		return "string"
+	case []interface{}:
+		return "array"
+	case map[string]interface{}:
+		return "object"
+	}
+	return fmt.Sprintf("%T", value)
+}
+
+func formatSchemaType(schemaType interface{}) string {
+	if name, ok := schemaType.(string); ok {
+		return name
+	}
+	return FormatJSONValue(schemaType)
+}
@@ -0,0 +1,106 @@
+package assert
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const userSchema = `{
+	"type": "object",
+	"required": ["id", "name", "roles"],
+	"additionalProperties": false,
+	"properties": {
+		"id": {"type": "integer", "minimum": 1},
+		"name": {"type": "string", "minLength": 1, "pattern": "^[A-Z]"},
+		"email": {"type": ["string", "null"]},
+		"roles": {"type": "array", "minItems": 1, "uniqueItems": true, "items": {"$ref": "#/definitions/role"}}
+	},
+	"definitions": {
+		"role": {"enum": ["admin", "user"]}
+	}
+}`
+
+func TestValidateJSONSchema(t *testing.T) {
+	violations, err := ValidateJSONSchema(userSchema, `{"id": 1, "name": "John", "email": null, "roles": ["admin"]}`)
+	assert.NoError(t, err)
+	assert.Empty(t, violations)
+}
+
+func TestValidateJSONSchema_Violations(t *testing.T) {
+	violations, err := ValidateJSONSchema(userSchema, `{"id": 1.5, "name": "john", "roles": ["admin", "admin", "guest"], "age": 3}`)
+	assert.NoError(t, err)
+	assert.Equal(t, []SchemaViolation{
+		{Path: "$.age", Message: "additional property is not allowed"},
+		{Path: "$.id", Message: "expected type integer, got number"},
+		{Path: "$.name", Message: `value "john" doesn't match pattern "^[A-Z]"`},
+		{Path: "$.roles", Message: "items 0 and 1 are equal"},
+		{Path: "$.roles[2]", Message: `value "guest" is not one of ["admin","user"]`},
+	}, violations)
+
+	violations, err = ValidateJSONSchema(userSchema, `[]`)
+	assert.NoError(t, err)
+	assert.Equal(t, []SchemaViolation{{Path: "$", Message: "expected type object, got array"}}, violations)
+
+	violations, err = ValidateJSONSchema(userSchema, `{"name": ""}`)
+	assert.NoError(t, err)
+	assert.Equal(t, []SchemaViolation{
+		{Path: "$", Message: `required property "id" is missing`},
+		{Path: "$", Message: `required property "roles" is missing`},
+		{Path: "$.name", Message: "expected at least 1 characters, got 0"},
+		{Path: "$.name", Message: `value "" doesn't match pattern "^[A-Z]"`},
+	}, violations)
+}
+
+func TestValidateJSONSchema_Combinators(t *testing.T) {
+	schema := `{"anyOf": [{"type": "string"}, {"type": "integer"}], "not": {"const": 0}, "oneOf": [{"maximum": 10}, {"minimum": 5}]}`
+
+	violations, err := ValidateJSONSchema(schema, `3`)
+	assert.NoError(t, err)
+	assert.Empty(t, violations)
+
+	violations, err = ValidateJSONSchema(schema, `7`)
+	assert.NoError(t, err)
+	assert.Equal(t, []SchemaViolation{{Path: "$", Message: "value matches 2 schemas of oneOf instead of one"}}, violations)
+
+	violations, err = ValidateJSONSchema(schema, `0`)
+	assert.NoError(t, err)
+	assert.Equal(t, []SchemaViolation{{Path: "$", Message: "value matches schema of not"}}, violations)
+
+	violations, err = ValidateJSONSchema(schema, `"text"`)
+	assert.NoError(t, err)
+	assert.Equal(t, []SchemaViolation{{Path: "$", Message: "value matches 2 schemas of oneOf instead of one"}}, violations)
+
+	violations, err = ValidateJSONSchema(schema, `11.5`)
+	assert.NoError(t, err)
+	assert.Equal(t, []SchemaViolation{{Path: "$", Message: "value doesn't match any schema of anyOf"}}, violations)
+}
+
+func TestValidateJSONSchema_Errors(t *testing.T) {
+	_, err := ValidateJSONSchema(`{`, `{}`)
+	assert.Error(t, err)
+
+	_, err = ValidateJSONSchema(`{}`, `{`)
+	assert.Error(t, err)
+
+	_, err = ValidateJSONSchema(`{"$ref": "other.json#/a"}`, `{}`)
+	assert.EqualError(t, err, `only local $ref is supported, got "other.json#/a"`)
+
+	_, err = ValidateJSONSchema(`{"$ref": "#/definitions/missing"}`, `{}`)
+	assert.EqualError(t, err, `$ref "#/definitions/missing" can't be resolved`)
+}
+
+func TestMatchesJSONSchema(t *testing.T) {
+	mockT := new(testing.T)
+	assert.True(t, MatchesJSONSchema(mockT, userSchema, `{"id": 1, "name": "John", "roles": ["user"]}`))
+	assert.False(t, MatchesJSONSchema(mockT, userSchema, `{"id": 1, "name": "John"}`))
+	assert.False(t, MatchesJSONSchema(mockT, userSchema, `{`))
+}
+
+func TestNoSchemaViolations(t *testing.T) {
+	mockT := new(testing.T)
+	assert.True(t, NoSchemaViolations(mockT, `{}`, nil, nil))
+	assert.False(t, NoSchemaViolations(mockT, `{}`, []SchemaViolation{{Path: "$", Message: "missing id"}}, nil))
+	assert.False(t, NoSchemaViolations(mockT, `{`, nil, errors.New("JSON parsing error")))
+}
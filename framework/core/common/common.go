@@ -11,6 +11,8 @@ import (
 	"github.com/louisun/allure-go-v2/allure"
 	"github.com/louisun/allure-go-v2/framework/asserts_wrapper/helper"
 	"github.com/louisun/allure-go-v2/framework/core/allure_manager/manager"
+	"github.com/louisun/allure-go-v2/framework/core/allure_manager/rerun"
+	"github.com/louisun/allure-go-v2/framework/core/allure_manager/selector"
 	"github.com/louisun/allure-go-v2/framework/core/allure_manager/testplan"
 	"github.com/louisun/allure-go-v2/framework/core/constants"
 	"github.com/louisun/allure-go-v2/framework/provider"
@@ -27,12 +29,15 @@ type Common struct {
 
 	xSkip bool
 
-	wg sync.WaitGroup
+	wg      sync.WaitGroup
+	timeout *testTimeout
+	ctx     testContext
+	guard   *resultGuard
 }
 
 // NewT returns Common instance that implementing provider.T interface
 func NewT(realT provider.TestingT) *Common {
-	newT := &Common{TestingT: realT}
+	newT := &Common{TestingT: newGuardedT(realT), timeout: newTestTimeout(), guard: new(resultGuard)}
 	newT.assert = helper.NewAssertsHelper(newT)
 	newT.require = helper.NewRequireHelper(newT)
 	return newT
@@ -40,24 +45,31 @@ func NewT(realT provider.TestingT) *Common {
 
 func (c *Common) registerError(fullMessage string) {
 	xSkipPrefix := "[XSkip]"
-	result := c.GetResult()
-	if result != nil && result.Status != allure.Broken {
-		if c.xSkip {
-			result.Name = fmt.Sprintf("%s%s", xSkipPrefix, result.Name)
-			c.Skip(fullMessage)
+	var skip bool
+	c.safely(func(result *allure.Result) {
+		if result.Status != allure.Broken {
+			if c.xSkip {
+				result.Name = fmt.Sprintf("%s%s", xSkipPrefix, result.Name)
+				skip = true
+			}
+			result.Status = allure.Failed
 		}
-		result.Status = allure.Failed
-	}
-	if result != nil {
-		result.StatusDetails.Message = extractErrorMessages(fullMessage)
-		result.StatusDetails.Trace = fmt.Sprintf("%s\n%s", result.StatusDetails.Trace, fullMessage)
+		// known/muted/flaky flags of result's status details are kept untouched
+		result.SetStatusMessage(extractErrorMessages(fullMessage))
+		result.SetStatusTrace(fmt.Sprintf("%s\n%s", result.GetStatusTrace(), fullMessage))
+	})
+	if skip {
+		c.Skip(fullMessage)
 	}
 }
 
+// safely changes the test result under the result guard
 func (c *Common) safely(f func(result *allure.Result)) {
-	if result := c.GetResult(); result != nil {
-		f(result)
-	}
+	c.guard.do(func() {
+		if result := c.GetResult(); result != nil {
+			f(result)
+		}
+	})
 }
 
 func (c *Common) SetProvider(provider provider.Provider) {
@@ -71,7 +83,7 @@ func (c *Common) WG() *sync.WaitGroup {
 
 // RealT returns instance of testing.T
 func (c *Common) RealT() provider.TestingT {
-	return c.TestingT
+	return unguarded(c.TestingT)
 }
 
 // Assert ...
@@ -84,6 +96,11 @@ func (c *Common) Require() provider.Asserts {
 	return c.require
 }
 
+// Expect starts the fluent assertion of the actual value. Failed link of the chain doesn't stop the test
+func (c *Common) Expect(actual interface{}) provider.Expectation {
+	return c.assert.Expect(actual)
+}
+
 // XSkip marks current test as XSkip that means that in case of assert fail this test will be marked skipped
 func (c *Common) XSkip() {
 	c.xSkip = true
@@ -96,18 +113,20 @@ func (c *Common) GetProvider() provider.Provider {
 
 // SkipOnPrint skips creating of report for current test
 func (c *Common) SkipOnPrint() {
-	c.GetResult().SkipOnPrint()
+	c.safely(func(result *allure.Result) {
+		result.SkipOnPrint()
+	})
 }
 
 // LogStep ...
 func (c *Common) LogStep(args ...interface{}) {
-	c.Provider.Step(allure.NewSimpleStep(fmt.Sprintln(args...)))
+	c.Step(allure.NewSimpleStep(fmt.Sprintln(args...)))
 	c.Log(args...)
 }
 
 // LogfStep ...
 func (c *Common) LogfStep(format string, args ...interface{}) {
-	c.Provider.Step(allure.NewSimpleStep(fmt.Sprintf(format, args...)))
+	c.Step(allure.NewSimpleStep(fmt.Sprintf(format, args...)))
 	c.Logf(format, args...)
 }
 
@@ -177,7 +196,9 @@ func (c *Common) Name() string {
 
 // Fail ...
 func (c *Common) Fail() {
-	c.GetProvider().GetResult().Status = allure.Failed
+	c.safely(func(result *allure.Result) {
+		result.Status = allure.Failed
+	})
 	c.TestingT.Fail()
 }
 
@@ -292,8 +313,9 @@ func (c *Common) Run(testName string, testBody func(provider.T), tags ...string)
 			callers = strings.Split(realT.Name(), "/")
 		)
 
-		if result := c.Provider.GetTestMeta().GetResult(); result != nil {
-			suiteName = result.Name
+		parentResult := c.Provider.GetTestMeta().GetResult()
+		if parentResult != nil {
+			suiteName = parentResult.Name
 		}
 
 		providerCfg := manager.NewProviderConfig().
@@ -309,10 +331,20 @@ func (c *Common) Run(testName string, testBody func(provider.T), tags ...string)
 
 		newProvider.NewTest(testName, packageName, tags...)
 		if testPlan := testplan.GetTestPlan(); testPlan != nil {
-			if !testPlan.IsSelected(newProvider.GetTestMeta().GetResult().TestCaseID, newProvider.GetResult().FullName) {
+			if !testPlan.IsResultSelected(newProvider.GetResult()) {
 				realT.Skip("Test is not Selected in Test Plan")
 			}
 		}
+		if failedTests := rerun.GetFailedTests(); failedTests != nil {
+			if !failedTests.Select(newProvider.GetResult()) {
+				realT.Skip("Test is not failed in the previous run")
+			}
+		}
+		// nested tests have no labels of the parent test, so they are run if the parent is selected
+		selected := parentResult != nil || selector.MatchResult(selector.GetExpression(), newProvider.GetResult())
+		if !selected && !selector.ReportSkipped() {
+			realT.Skip(selector.SkipMessage())
+		}
 		newProvider.TestContext()
 
 		testT.SetProvider(newProvider)
@@ -323,30 +355,36 @@ func (c *Common) Run(testName string, testBody func(provider.T), tags ...string)
 
 		// print test result
 		defer func() {
+			testT.Seal()
 			err := testT.Provider.FinishTest()
 			if err != nil {
 				testT.Error(err.Error())
 			}
 		}()
 
-		defer func() {
-			rec := recover()
-			// wait for all test's async steps over
-			testT.wg.Wait()
-			if rec != nil {
-				errMsg := fmt.Sprintf("Test panicked: %v\n%s", rec, debug.Stack())
-				TestError(testT, testT.Provider, testT.Provider.ExecutionContext().GetName(), errMsg)
-			}
-		}()
+		if !selected {
+			testT.Skipf("%s", selector.SkipMessage())
+		}
 
 		testT.Provider.TestContext()
-		testBody(testT)
+		testT.ExecuteWithTimeout(func() {
+			defer func() {
+				rec := recover()
+				// wait for all test's async steps over
+				testT.wg.Wait()
+				if rec != nil {
+					errMsg := fmt.Sprintf("Test panicked: %v\n%s", rec, debug.Stack())
+					TestError(testT, testT, testT.Provider.ExecutionContext().GetName(), errMsg)
+				}
+			}()
+			testBody(testT)
+		})
 	})
 	return
 }
 
 func (c *Common) SetRealT(realT provider.TestingT) {
-	c.TestingT = realT
+	c.TestingT = newGuardedT(realT)
 }
 
 func (c *Common) GetRealT() provider.TestingT {
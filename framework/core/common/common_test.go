@@ -96,7 +96,7 @@ type suiteMetaMockCommon struct {
 	namePrefix string
 	name       string
 	container  *allure.Container
-	hook       func(t provider.T)
+	hook       []provider.Hook
 }
 
 func (m *suiteMetaMockCommon) GetPackageName() string {
@@ -123,27 +123,27 @@ func (m *suiteMetaMockCommon) GetContainer() *allure.Container {
 	return m.container
 }
 
-func (m *suiteMetaMockCommon) SetBeforeAll(hook func(provider.T)) {
-	m.hook = hook
+func (m *suiteMetaMockCommon) SetBeforeAll(hooks ...provider.Hook) {
+	m.hook = hooks
 }
 
-func (m *suiteMetaMockCommon) SetAfterAll(hook func(provider.T)) {
-	m.hook = hook
+func (m *suiteMetaMockCommon) SetAfterAll(hooks ...provider.Hook) {
+	m.hook = hooks
 }
 
-func (m *suiteMetaMockCommon) GetBeforeAll() func(provider.T) {
+func (m *suiteMetaMockCommon) GetBeforeAll() []provider.Hook {
 	return m.hook
 }
 
-func (m *suiteMetaMockCommon) GetAfterAll() func(provider.T) {
+func (m *suiteMetaMockCommon) GetAfterAll() []provider.Hook {
 	return m.hook
 }
 
 type testMetaMockCommon struct {
 	result    *allure.Result
 	container *allure.Container
-	be        func(t provider.T)
-	ae        func(t provider.T)
+	be        []provider.Hook
+	ae        []provider.Hook
 }
 
 func (m *testMetaMockCommon) GetResult() *allure.Result {
@@ -158,19 +158,19 @@ func (m *testMetaMockCommon) GetContainer() *allure.Container {
 	return m.container
 }
 
-func (m *testMetaMockCommon) SetBeforeEach(hook func(t provider.T)) {
-	m.be = hook
+func (m *testMetaMockCommon) SetBeforeEach(hooks ...provider.Hook) {
+	m.be = hooks
 }
 
-func (m *testMetaMockCommon) GetBeforeEach() func(t provider.T) {
+func (m *testMetaMockCommon) GetBeforeEach() []provider.Hook {
 	return m.be
 }
 
-func (m *testMetaMockCommon) SetAfterEach(hook func(t provider.T)) {
-	m.ae = hook
+func (m *testMetaMockCommon) SetAfterEach(hooks ...provider.Hook) {
+	m.ae = hooks
 }
 
-func (m *testMetaMockCommon) GetAfterEach() func(t provider.T) {
+func (m *testMetaMockCommon) GetAfterEach() []provider.Hook {
 	return m.ae
 }
 
@@ -251,6 +251,23 @@ func TestCommon_Errorf(t *testing.T) {
 	require.True(t, mock.errorfFlag)
 }
 
+func TestCommon_Error_KeepStatusDetailsFlags(t *testing.T) {
+	mock := newCommonTMock()
+	providerMock := newProviderMockCommon("name", "fullName")
+	comm := Common{TestingT: mock, Provider: providerMock}
+	providerMock.GetResult().SetKnown(true)
+	providerMock.GetResult().SetMuted(true)
+	providerMock.GetResult().SetFlaky(true)
+
+	comm.Errorf("test")
+	require.True(t, mock.errorfFlag)
+	require.Equal(t, allure.Failed, providerMock.GetResult().Status)
+	require.Equal(t, "test", providerMock.GetResult().GetStatusMessage())
+	require.True(t, providerMock.GetResult().StatusDetails.Known)
+	require.True(t, providerMock.GetResult().StatusDetails.Muted)
+	require.True(t, providerMock.GetResult().StatusDetails.Flaky)
+}
+
 func TestCommon_WG(t *testing.T) {
 	comm := Common{wg: sync.WaitGroup{}}
 	require.NotNil(t, comm.WG())
@@ -0,0 +1,100 @@
+package common
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/louisun/allure-go-v2/framework/provider"
+)
+
+// deadliner is implemented by *testing.T
+type deadliner interface {
+	Deadline() (deadline time.Time, ok bool)
+}
+
+// testContext is the context of the test bound to testing.T it was created for
+type testContext struct {
+	mu     sync.Mutex
+	realT  provider.TestingT
+	ctx    context.Context
+	cancel context.CancelFunc
+}
+
+// get returns context bound to the realT. New context is created if there is no one or it's bound to other testing.T,
+// e.g. if the test's testing.T was replaced with the hook's one.
+func (tc *testContext) get(realT provider.TestingT, parent provider.StepParent) context.Context {
+	tc.mu.Lock()
+	defer tc.mu.Unlock()
+
+	if tc.ctx != nil && tc.realT == realT {
+		return tc.ctx
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	if t, ok := realT.(deadliner); ok {
+		if deadline, ok := t.Deadline(); ok {
+			cancel()
+			ctx, cancel = context.WithDeadline(context.Background(), deadline)
+		}
+	}
+	realT.Cleanup(cancel)
+	tc.realT, tc.ctx, tc.cancel = realT, provider.ContextWithStep(ctx, parent), cancel
+	return tc.ctx
+}
+
+// cancelContext cancels the context, if it was created
+func (tc *testContext) cancelContext() {
+	tc.mu.Lock()
+	defer tc.mu.Unlock()
+
+	if tc.cancel != nil {
+		tc.cancel()
+	}
+}
+
+// Context returns context carrying the test as the current step. It has the deadline of testing.T
+// and is cancelled when the test (or the hook it's called from) ends or times out.
+func (c *Common) Context() context.Context {
+	return c.ctx.get(c.RealT(), c)
+}
+
+// stepContext is the context of the step
+type stepContext struct {
+	mu     sync.Mutex
+	ctx    context.Context
+	cancel context.CancelFunc
+	done   bool
+}
+
+// Context returns context carrying the step as the current step. It's derived from the context of the parent
+// step or test and is cancelled when the step ends.
+func (ctx *stepCtx) Context() context.Context {
+	var parent context.Context
+	if ctx.parentStep != nil {
+		parent = ctx.parentStep.Context()
+	} else {
+		parent = ctx.t.Context()
+	}
+
+	ctx.stepContext.mu.Lock()
+	defer ctx.stepContext.mu.Unlock()
+	if ctx.stepContext.ctx == nil {
+		stepCtx, cancel := context.WithCancel(parent)
+		ctx.stepContext.ctx, ctx.stepContext.cancel = provider.ContextWithStep(stepCtx, ctx), cancel
+		if ctx.stepContext.done {
+			cancel()
+		}
+	}
+	return ctx.stepContext.ctx
+}
+
+// cancelContext cancels context of the step. Context requested after the step end is cancelled at once
+func (ctx *stepCtx) cancelContext() {
+	ctx.stepContext.mu.Lock()
+	defer ctx.stepContext.mu.Unlock()
+
+	ctx.stepContext.done = true
+	if ctx.stepContext.cancel != nil {
+		ctx.stepContext.cancel()
+	}
+}
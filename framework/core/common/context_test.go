@@ -0,0 +1,70 @@
+package common
+
+import (
+	"context"
+	"testing"
+
+	"github.com/louisun/allure-go-v2/framework/provider"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCommon_Context(t *testing.T) {
+	var ctx context.Context
+	t.Run("test", func(realT *testing.T) {
+		c := NewT(realT)
+		ctx = c.Context()
+		require.Same(t, ctx, c.Context())
+
+		step, ok := provider.StepFromContext(ctx)
+		require.True(t, ok)
+		require.Equal(t, c, step)
+
+		deadline, hasDeadline := realT.Deadline()
+		ctxDeadline, ctxHasDeadline := ctx.Deadline()
+		require.Equal(t, hasDeadline, ctxHasDeadline)
+		require.Equal(t, deadline, ctxDeadline)
+		require.NoError(t, ctx.Err())
+	})
+	require.ErrorIs(t, ctx.Err(), context.Canceled)
+}
+
+func TestCommon_Context_newRealT(t *testing.T) {
+	c := NewT(t)
+	testCtx := c.Context()
+
+	var hookCtx context.Context
+	t.Run("hook", func(realT *testing.T) {
+		c.SetRealT(realT)
+		hookCtx = c.Context()
+	})
+	c.SetRealT(t)
+
+	require.NotSame(t, testCtx, hookCtx)
+	require.Error(t, hookCtx.Err())
+	require.NoError(t, testCtx.Err())
+}
+
+func TestStepCtx_Context(t *testing.T) {
+	c := NewT(t)
+	sCtx := NewStepCtx(c, nil, "step")
+	ctx := sCtx.Context()
+
+	step, ok := provider.StepFromContext(ctx)
+	require.True(t, ok)
+	require.Equal(t, sCtx, step)
+
+	child := sCtx.(*stepCtx).NewChildCtx("child")
+	childCtx := child.Context()
+	step, ok = provider.StepFromContext(childCtx)
+	require.True(t, ok)
+	require.Equal(t, child, step)
+
+	sCtx.cancelContext()
+	require.ErrorIs(t, ctx.Err(), context.Canceled)
+	require.ErrorIs(t, childCtx.Err(), context.Canceled)
+	require.NoError(t, c.Context().Err())
+
+	late := NewStepCtx(c, nil, "late")
+	late.cancelContext()
+	require.Error(t, late.Context().Err())
+}
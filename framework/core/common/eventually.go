@@ -0,0 +1,87 @@
+package common
+
+import (
+	"flag"
+	"fmt"
+	"time"
+
+	"github.com/louisun/allure-go-v2/allure"
+	"github.com/louisun/allure-go-v2/framework/provider"
+)
+
+// keptAttemptsAttachments is the number of the last attempts of the eventually step, which keep their attachments.
+// Attachments of earlier attempts are dropped to keep the report small when condition is polled for a long time.
+// Negative value keeps attachments of all attempts.
+var keptAttemptsAttachments = flag.Int("allure-go.eventually-attachments", 3, "number of the last attempts of the allure-go eventually step, which keep their attachments. Negative value keeps all")
+
+// WithEventuallyStep opens nesting for struct.Step and polls condition every interval until it returns true or timeout expires.
+// Every attempt is recorded as the child step. Attempt, in which condition returns false, is marked failed.
+// If condition is not satisfied in time, the step and the test are marked failed.
+func (c *Common) WithEventuallyStep(stepName string, timeout, interval time.Duration, condition func(sCtx provider.StepCtx) bool, params ...*allure.Parameter) {
+	c.WithNewStep(stepName, func(sCtx provider.StepCtx) {
+		runEventually(sCtx, stepName, timeout, interval, condition)
+	}, params...)
+}
+
+// runEventually polls condition in child steps of sCtx. Polling stops when condition is satisfied,
+// timeout expires or context of the step is done.
+func runEventually(sCtx provider.StepCtx, stepName string, timeout, interval time.Duration, condition func(sCtx provider.StepCtx) bool) {
+	var (
+		start     = time.Now()
+		deadline  = start.Add(timeout)
+		attempts  []*allure.Step
+		satisfied bool
+	)
+	for {
+		sCtx.WithNewStep(fmt.Sprintf("Attempt %d", len(attempts)+1), func(aCtx provider.StepCtx) {
+			attempts = append(attempts, aCtx.CurrentStep())
+			aCtx.WithNewParameters("Elapsed", time.Since(start).Round(time.Millisecond))
+			if satisfied = condition(aCtx); !satisfied {
+				aCtx.CurrentStep().Failed()
+			}
+		})
+		if old := len(attempts) - *keptAttemptsAttachments - 1; *keptAttemptsAttachments >= 0 && old >= 0 {
+			dropAttachments(attempts[old])
+		}
+		if satisfied {
+			break
+		}
+
+		wait := time.Until(deadline)
+		if wait <= 0 {
+			break
+		}
+		if interval < wait {
+			wait = interval
+		}
+		if !sleepCtx(sCtx, wait) {
+			break
+		}
+	}
+
+	sCtx.WithNewParameters("Attempts", len(attempts))
+	if !satisfied {
+		sCtx.Errorf("Condition of step %q was not satisfied after %d attempts in %s",
+			stepName, len(attempts), time.Since(start).Round(time.Millisecond))
+	}
+}
+
+// sleepCtx waits for duration. Returns false if context of the step is done earlier
+func sleepCtx(sCtx provider.StepCtx, duration time.Duration) bool {
+	timer := time.NewTimer(duration)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return true
+	case <-sCtx.Context().Done():
+		return false
+	}
+}
+
+// dropAttachments removes attachments of the step and all its children
+func dropAttachments(step *allure.Step) {
+	step.Attachments = nil
+	for _, child := range step.Steps {
+		dropAttachments(child)
+	}
+}
@@ -0,0 +1,98 @@
+package common
+
+import (
+	"testing"
+	"time"
+
+	"github.com/louisun/allure-go-v2/allure"
+	"github.com/louisun/allure-go-v2/framework/provider"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStepCtx_WithEventuallyStep(t *testing.T) {
+	mockT := newStepProviderMock()
+	mockT.SetRealT(t)
+	ctx := stepCtx{t: mockT, currentStep: allure.NewSimpleStep("testStep")}
+
+	var calls int
+	ctx.WithEventuallyStep("new step", time.Second, time.Millisecond, func(sCtx provider.StepCtx) bool {
+		calls++
+		return calls == 3
+	})
+	require.Equal(t, 3, calls)
+	require.False(t, mockT.failed)
+	require.Len(t, ctx.currentStep.Steps, 1)
+
+	step := ctx.currentStep.Steps[0]
+	require.Equal(t, allure.Passed, step.Status)
+	require.Len(t, step.Steps, 3)
+	require.Equal(t, "Attempt 1", step.Steps[0].Name)
+	require.Equal(t, allure.Failed, step.Steps[0].Status)
+	require.Equal(t, "Attempt 2", step.Steps[1].Name)
+	require.Equal(t, allure.Failed, step.Steps[1].Status)
+	require.Equal(t, "Attempt 3", step.Steps[2].Name)
+	require.Equal(t, allure.Passed, step.Steps[2].Status)
+	require.Equal(t, "Elapsed", step.Steps[2].Parameters[0].Name)
+
+	require.Len(t, step.Parameters, 1)
+	require.Equal(t, "Attempts", step.Parameters[0].Name)
+	require.Equal(t, "3", step.Parameters[0].GetValue())
+}
+
+func TestStepCtx_WithEventuallyStep_keptAttachments(t *testing.T) {
+	defer func(kept int) { *keptAttemptsAttachments = kept }(*keptAttemptsAttachments)
+
+	run := func(kept int) *allure.Step {
+		*keptAttemptsAttachments = kept
+		mockT := newStepProviderMock()
+		mockT.SetRealT(t)
+		ctx := stepCtx{t: mockT, currentStep: allure.NewSimpleStep("testStep")}
+
+		var calls int
+		ctx.WithEventuallyStep("new step", time.Second, time.Millisecond, func(sCtx provider.StepCtx) bool {
+			sCtx.WithNewAttachment("response", allure.Text, []byte("pending"))
+			calls++
+			return calls == 5
+		})
+		require.False(t, mockT.failed)
+		require.Len(t, ctx.currentStep.Steps, 1)
+		require.Len(t, ctx.currentStep.Steps[0].Steps, 5)
+		return ctx.currentStep.Steps[0]
+	}
+
+	step := run(1)
+	for _, attempt := range step.Steps[:4] {
+		require.Empty(t, attempt.Attachments)
+	}
+	require.Len(t, step.Steps[4].Attachments, 1)
+
+	step = run(-1)
+	for _, attempt := range step.Steps {
+		require.Len(t, attempt.Attachments, 1)
+	}
+}
+
+func TestStepCtx_WithEventuallyStep_expired(t *testing.T) {
+	mockT := newStepProviderMock()
+	mockT.SetRealT(t)
+	ctx := stepCtx{t: mockT, currentStep: allure.NewSimpleStep("testStep")}
+
+	ctx.WithEventuallyStep("new step", 50*time.Millisecond, 5*time.Millisecond, func(sCtx provider.StepCtx) bool {
+		sCtx.WithNewAttachment("response", allure.Text, []byte("pending"))
+		return false
+	})
+	require.True(t, mockT.errorF)
+	require.Len(t, ctx.currentStep.Steps, 1)
+
+	step := ctx.currentStep.Steps[0]
+	require.Equal(t, allure.Failed, step.Status)
+	require.Greater(t, len(step.Steps), *keptAttemptsAttachments)
+	for i, attempt := range step.Steps {
+		require.Equal(t, allure.Failed, attempt.Status)
+		if i < len(step.Steps)-*keptAttemptsAttachments {
+			require.Empty(t, attempt.Attachments)
+		} else {
+			require.Len(t, attempt.Attachments, 1)
+		}
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"runtime/debug"
 	"testing"
 
+	"github.com/louisun/allure-go-v2/allure"
 	"github.com/louisun/allure-go-v2/framework/provider"
 )
 
@@ -20,43 +21,117 @@ const (
 	AfterEach  HookType = "AfterEach"
 )
 
-func CarriedHook(hook HookType, getHookBody func() func(t provider.T)) HookFunc {
+// CarriedHook returns HookFunc running the hooks returned by getHooks.
+// Before hooks are run in order they were added, after hooks are run in reverse order.
+// Every hook is run as a separate sub-test. Before hooks stop at the first failed one, after hooks are all run.
+// Steps of the named hook are grouped into the step with its name.
+func CarriedHook(hook HookType, getHooks func() []provider.Hook) HookFunc {
 	return func(t InternalT, provider HookProvider) (result bool, err error) {
 		result = true
-		if hookBody := getHookBody(); hookBody != nil {
-			t.WG().Add(1)
-			defer t.WG().Wait()
-
-			// for correct logs
-			oldT := t.RealT()
-			defer t.SetRealT(oldT)
-
-			// VERY dirt hack.
-			// That allows let testing library control routines to avoid deadlocks and appropriate waiting
-			result = t.RealT().Run(string(hook), func(realT *testing.T) {
-				defer t.WG().Done()
-				switch hook {
-				case BeforeAll:
-					provider.BeforeAllContext()
-				case AfterAll:
-					provider.AfterAllContext()
-				case BeforeEach:
-					provider.BeforeEachContext()
-				case AfterEach:
-					provider.AfterEachContext()
-				}
-				defer func() {
-					r := recover()
-					if r != nil {
-						err = fmt.Errorf("%s hook panicked:%v\n%s", hook, r, debug.Stack())
-						t.Errorf("%s hook panicked:%v\n%s", hook, r, debug.Stack())
-						t.FailNow()
-					}
-				}()
-				t.SetRealT(realT)
-				hookBody(t)
-			})
+		hooks := getHooks()
+		reverse := hook == AfterAll || hook == AfterEach
+		for i := range hooks {
+			h := hooks[i]
+			if reverse {
+				h = hooks[len(hooks)-1-i]
+			}
+			if h.Body == nil {
+				continue
+			}
+			ok, hookErr := runCarriedHook(t, provider, hook, h)
+			if !ok {
+				result = false
+			}
+			if hookErr != nil && err == nil {
+				err = hookErr
+			}
+			if !result && !reverse {
+				return
+			}
 		}
 		return
 	}
 }
+
+func runCarriedHook(t InternalT, provider HookProvider, hook HookType, h provider.Hook) (result bool, err error) {
+	t.WG().Add(1)
+	defer t.WG().Wait()
+
+	// for correct logs
+	oldT := t.RealT()
+	defer t.SetRealT(oldT)
+
+	name := string(hook)
+	if h.Name != "" {
+		name = fmt.Sprintf("%s %s", hook, h.Name)
+	}
+	guard := guardOf(t)
+	steps := hookSteps(hook, provider)
+	var first int
+	guard.do(func() { first = len(*steps) })
+	start := allure.GetNow()
+	if h.Name != "" {
+		defer func() {
+			t.WG().Wait()
+			guard.do(func() { groupHookSteps(steps, first, h.Name, start, result) })
+		}()
+	}
+
+	// VERY dirt hack.
+	// That allows let testing library control routines to avoid deadlocks and appropriate waiting
+	result = t.RealT().Run(name, func(realT *testing.T) {
+		defer t.WG().Done()
+		switch hook {
+		case BeforeAll:
+			provider.BeforeAllContext()
+		case AfterAll:
+			provider.AfterAllContext()
+		case BeforeEach:
+			provider.BeforeEachContext()
+		case AfterEach:
+			provider.AfterEachContext()
+		}
+		defer func() {
+			r := recover()
+			if r != nil {
+				err = fmt.Errorf("%s hook panicked:%v\n%s", name, r, debug.Stack())
+				t.Errorf("%s hook panicked:%v\n%s", name, r, debug.Stack())
+				t.FailNow()
+			}
+		}()
+		t.SetRealT(realT)
+		h.Body(t)
+	})
+	return
+}
+
+// hookSteps returns steps of the container the hook adds its steps to
+func hookSteps(hook HookType, provider HookProvider) *[]*allure.Step {
+	switch hook {
+	case BeforeAll:
+		return &provider.GetSuiteMeta().GetContainer().Befores
+	case AfterAll:
+		return &provider.GetSuiteMeta().GetContainer().Afters
+	case BeforeEach:
+		return &provider.GetTestMeta().GetContainer().Befores
+	default:
+		return &provider.GetTestMeta().GetContainer().Afters
+	}
+}
+
+// groupHookSteps moves steps added by the hook into the step named after the hook
+func groupHookSteps(steps *[]*allure.Step, first int, name string, start int64, passed bool) {
+	if first > len(*steps) {
+		first = len(*steps)
+	}
+	hookStep := allure.NewSimpleStep(name)
+	hookStep.Start = start
+	hookStep.Finish()
+	if !passed {
+		hookStep.Failed()
+	}
+	for _, step := range (*steps)[first:] {
+		step.WithParent(hookStep)
+	}
+	*steps = append((*steps)[:first], hookStep)
+}
@@ -18,7 +18,7 @@ type suiteMetaMockHooks struct {
 
 	baFlag bool
 	aaFlag bool
-	hook   func(t provider.T)
+	hook   []provider.Hook
 }
 
 func (m *suiteMetaMockHooks) GetPackageName() string {
@@ -45,20 +45,20 @@ func (m *suiteMetaMockHooks) GetContainer() *allure.Container {
 	return m.container
 }
 
-func (m *suiteMetaMockHooks) SetBeforeAll(hook func(provider.T)) {
-	m.hook = hook
+func (m *suiteMetaMockHooks) SetBeforeAll(hooks ...provider.Hook) {
+	m.hook = hooks
 }
 
-func (m *suiteMetaMockHooks) SetAfterAll(hook func(provider.T)) {
-	m.hook = hook
+func (m *suiteMetaMockHooks) SetAfterAll(hooks ...provider.Hook) {
+	m.hook = hooks
 }
 
-func (m *suiteMetaMockHooks) GetBeforeAll() func(provider.T) {
+func (m *suiteMetaMockHooks) GetBeforeAll() []provider.Hook {
 	m.baFlag = true
 	return m.hook
 }
 
-func (m *suiteMetaMockHooks) GetAfterAll() func(provider.T) {
+func (m *suiteMetaMockHooks) GetAfterAll() []provider.Hook {
 	m.aaFlag = true
 	return m.hook
 }
@@ -68,9 +68,9 @@ type testMetaMockHooks struct {
 	container *allure.Container
 
 	beFlag bool
-	be     func(t provider.T)
+	be     []provider.Hook
 	aeFlag bool
-	ae     func(t provider.T)
+	ae     []provider.Hook
 }
 
 func (m *testMetaMockHooks) GetResult() *allure.Result {
@@ -85,20 +85,20 @@ func (m *testMetaMockHooks) GetContainer() *allure.Container {
 	return m.container
 }
 
-func (m *testMetaMockHooks) SetBeforeEach(hook func(t provider.T)) {
-	m.be = hook
+func (m *testMetaMockHooks) SetBeforeEach(hooks ...provider.Hook) {
+	m.be = hooks
 }
 
-func (m *testMetaMockHooks) GetBeforeEach() func(t provider.T) {
+func (m *testMetaMockHooks) GetBeforeEach() []provider.Hook {
 	m.beFlag = true
 	return m.be
 }
 
-func (m *testMetaMockHooks) SetAfterEach(hook func(t provider.T)) {
-	m.ae = hook
+func (m *testMetaMockHooks) SetAfterEach(hooks ...provider.Hook) {
+	m.ae = hooks
 }
 
-func (m *testMetaMockHooks) GetAfterEach() func(t provider.T) {
+func (m *testMetaMockHooks) GetAfterEach() []provider.Hook {
 	m.aeFlag = true
 	return m.ae
 }
@@ -191,7 +191,7 @@ func TestBeforeAllHook(t *testing.T) {
 	tMock := &hookTMock{wg: &sync.WaitGroup{}, realT: &realTMock{}}
 	hookBody := func(t provider.T) {}
 	providerMock := &hookProviderMock{
-		suiteMeta: &suiteMetaMockHooks{hook: hookBody},
+		suiteMeta: &suiteMetaMockHooks{hook: []provider.Hook{{Body: hookBody}}},
 		testMeta:  &testMetaMockHooks{},
 	}
 
@@ -217,7 +217,7 @@ func TestBeforeEachHook(t *testing.T) {
 	tMock := &hookTMock{wg: &sync.WaitGroup{}, realT: &realTMock{}}
 	providerMock := &hookProviderMock{
 		suiteMeta: &suiteMetaMockHooks{},
-		testMeta:  &testMetaMockHooks{be: func(t provider.T) {}},
+		testMeta:  &testMetaMockHooks{be: []provider.Hook{{Body: func(t provider.T) {}}}},
 	}
 
 	hookFunc := CarriedHook(BeforeEach, providerMock.GetTestMeta().GetBeforeEach)
@@ -240,7 +240,7 @@ func TestAfterAllHook(t *testing.T) {
 	t.Skip("This test need to be reworked cause deadlock in mocks")
 	tMock := &hookTMock{wg: &sync.WaitGroup{}, realT: &realTMock{}}
 	providerMock := &hookProviderMock{
-		suiteMeta: &suiteMetaMockHooks{hook: func(t provider.T) {}},
+		suiteMeta: &suiteMetaMockHooks{hook: []provider.Hook{{Body: func(t provider.T) {}}}},
 		testMeta:  &testMetaMockHooks{},
 	}
 
@@ -265,7 +265,7 @@ func TestAfterEachHook(t *testing.T) {
 	tMock := &hookTMock{wg: &sync.WaitGroup{}, realT: &realTMock{}}
 	providerMock := &hookProviderMock{
 		suiteMeta: &suiteMetaMockHooks{},
-		testMeta:  &testMetaMockHooks{ae: func(t provider.T) {}},
+		testMeta:  &testMetaMockHooks{ae: []provider.Hook{{Body: func(t provider.T) {}}}},
 	}
 
 	hookFunc := CarriedHook(AfterEach, providerMock.GetTestMeta().GetAfterEach)
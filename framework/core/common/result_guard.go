@@ -0,0 +1,117 @@
+package common
+
+import (
+	"sync"
+
+	"github.com/louisun/allure-go-v2/allure"
+)
+
+// resultGuard serializes changes of the test result made by the test's goroutines.
+// Once the guard is sealed, changes are ignored, so the result can be printed
+// while goroutine abandoned by timeout keeps running.
+type resultGuard struct {
+	mu     sync.Mutex
+	sealed bool
+}
+
+// do runs f unless the guard is sealed. f must not call do itself.
+func (g *resultGuard) do(f func()) {
+	if g == nil {
+		f()
+		return
+	}
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if !g.sealed {
+		f()
+	}
+}
+
+func (g *resultGuard) seal() {
+	if g == nil {
+		return
+	}
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.sealed = true
+}
+
+// guarded is implemented by the test, which result is protected by the guard
+type guarded interface {
+	resultGuard() *resultGuard
+}
+
+// guardOf returns the guard of the test, or nil if t has none
+func guardOf(t interface{}) *resultGuard {
+	if g, ok := t.(guarded); ok {
+		return g.resultGuard()
+	}
+	return nil
+}
+
+func (c *Common) resultGuard() *resultGuard {
+	return c.guard
+}
+
+// Seal stops changes of the test result. Steps, attachments, parameters and statuses
+// reported after the call, e.g. by goroutine abandoned by timeout, are ignored.
+// Seal must be called before the result is printed.
+func (c *Common) Seal() {
+	c.guard.seal()
+}
+
+// Detached returns the test to run after each hooks with, if goroutine of the test was abandoned by timeout.
+// Detached test reports to the same result, but doesn't share testing.T with the abandoned goroutine,
+// so hooks replacing it don't race with the goroutine. Otherwise the test itself is returned.
+func (c *Common) Detached() *Common {
+	if t, ok := c.TestingT.(*guardedT); !ok || !t.isAbandoned() {
+		return c
+	}
+	detached := NewT(c.RealT())
+	detached.SetProvider(c.Provider)
+	detached.guard = c.guard
+	detached.xSkip = c.xSkip
+	return detached
+}
+
+// Step ...
+func (c *Common) Step(step *allure.Step) {
+	c.guard.do(func() { c.Provider.Step(step) })
+}
+
+// NewStep ...
+func (c *Common) NewStep(stepName string, parameters ...*allure.Parameter) {
+	c.guard.do(func() { c.Provider.NewStep(stepName, parameters...) })
+}
+
+// WithAttachments ...
+func (c *Common) WithAttachments(attachments ...*allure.Attachment) {
+	c.guard.do(func() { c.Provider.WithAttachments(attachments...) })
+}
+
+// WithNewAttachment ...
+func (c *Common) WithNewAttachment(name string, mimeType allure.MimeType, content []byte) {
+	c.guard.do(func() { c.Provider.WithNewAttachment(name, mimeType, content) })
+}
+
+// WithParameters ...
+func (c *Common) WithParameters(params ...*allure.Parameter) {
+	c.guard.do(func() { c.Provider.WithParameters(params...) })
+}
+
+// WithNewParameters ...
+func (c *Common) WithNewParameters(kv ...interface{}) {
+	c.guard.do(func() { c.Provider.WithNewParameters(kv...) })
+}
+
+// StopResult ...
+func (c *Common) StopResult(status allure.Status) {
+	c.guard.do(func() { c.Provider.StopResult(status) })
+}
+
+// UpdateResultStatus ...
+func (c *Common) UpdateResultStatus(msg string, trace string) {
+	c.guard.do(func() { c.Provider.UpdateResultStatus(msg, trace) })
+}
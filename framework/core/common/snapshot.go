@@ -0,0 +1,42 @@
+package common
+
+// snapshotLocator is implemented by tests, which can match snapshots
+type snapshotLocator interface {
+	SnapshotLocation() (suite string, test string)
+}
+
+// namedTestMeta is implemented by test meta, which knows the name the test was created with
+type namedTestMeta interface {
+	GetName() string
+}
+
+// SnapshotLocation returns names of the suite and the test, which snapshots of the test are stored under.
+// The test is named after its method or case, so the title of the test doesn't move its snapshots.
+func (c *Common) SnapshotLocation() (suite string, test string) {
+	if c.Provider == nil {
+		return "", c.Name()
+	}
+	if c.Provider.GetSuiteMeta() != nil {
+		suite = c.Provider.GetSuiteMeta().GetSuiteName()
+	}
+	if meta, ok := c.Provider.GetTestMeta().(namedTestMeta); ok && meta.GetName() != "" {
+		return suite, meta.GetName()
+	}
+	return suite, c.Name()
+}
+
+// SnapshotLocation returns names of the suite and the test, which the step belongs to
+func (ctx *stepCtx) SnapshotLocation() (suite string, test string) {
+	if locator, ok := ctx.t.(snapshotLocator); ok {
+		return locator.SnapshotLocation()
+	}
+	return "", ctx.t.Name()
+}
+
+// SnapshotLocation returns names of the suite and the test, which the group of assertions belongs to
+func (s *softAsserts) SnapshotLocation() (suite string, test string) {
+	if locator, ok := s.sCtx.(snapshotLocator); ok {
+		return locator.SnapshotLocation()
+	}
+	return "", ""
+}
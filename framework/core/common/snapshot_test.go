@@ -0,0 +1,51 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/louisun/allure-go-v2/allure"
+	"github.com/louisun/allure-go-v2/framework/core/allure_manager/manager"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCommon_SnapshotLocation(t *testing.T) {
+	mockProvider := newProviderMockCommon("TestA", "Suite/TestA")
+	mockProvider.suiteMetaMock = &suiteMetaMockCommon{name: "Suite"}
+	comm := &Common{Provider: mockProvider}
+
+	suite, test := comm.SnapshotLocation()
+	require.Equal(t, "Suite", suite)
+	require.Equal(t, "TestA", test)
+
+	ctx := stepCtx{t: comm, currentStep: allure.NewSimpleStep("step")}
+	suite, test = ctx.SnapshotLocation()
+	require.Equal(t, "Suite", suite)
+	require.Equal(t, "TestA", test)
+
+	group := &softAsserts{sCtx: &ctx}
+	suite, test = group.SnapshotLocation()
+	require.Equal(t, "Suite", suite)
+	require.Equal(t, "TestA", test)
+}
+
+func TestCommon_SnapshotLocation_title(t *testing.T) {
+	p := manager.NewProvider(manager.NewProviderConfig().WithSuiteName("Suite"))
+	p.NewTest("TestA", "package")
+	comm := &Common{Provider: p}
+
+	comm.Title("Readable title")
+	suite, test := comm.SnapshotLocation()
+	require.Equal(t, "Suite", suite)
+	require.Equal(t, "TestA", test)
+	require.Equal(t, "Readable title", comm.Name())
+}
+
+func TestStepCtx_SnapshotLocation_noLocator(t *testing.T) {
+	mockT := newStepProviderMock()
+	mockT.name = "TestB"
+	ctx := stepCtx{t: mockT, currentStep: allure.NewSimpleStep("step")}
+
+	suite, test := ctx.SnapshotLocation()
+	require.Empty(t, suite)
+	require.Equal(t, "TestB", test)
+}
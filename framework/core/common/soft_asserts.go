@@ -0,0 +1,85 @@
+package common
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/louisun/allure-go-v2/allure"
+	"github.com/louisun/allure-go-v2/framework/asserts_wrapper/helper"
+	"github.com/louisun/allure-go-v2/framework/provider"
+)
+
+const (
+	// softAssertsFail marks the test failed and lets it go on
+	softAssertsFail = "fail"
+	// softAssertsStop marks the test failed and stops it
+	softAssertsStop = "stop"
+	// softAssertsMark marks only the group's step failed
+	softAssertsMark = "mark"
+)
+
+var softAssertsMode = flag.String("allure-go.soft-asserts", softAssertsFail, "what failed soft assertion group does with the allure-go test: fail, stop or mark")
+
+// SoftAssert runs all assertions of the group as child steps of the step with the name, even if some of them fail.
+// Failures of the group are listed in status details of the step and reported to the test at once
+// according to -allure-go.soft-asserts flag.
+func (c *Common) SoftAssert(name string, assertions func(a provider.Asserts)) {
+	c.WithNewStep(name, func(sCtx provider.StepCtx) {
+		runSoftAsserts(sCtx, assertions)
+	})
+}
+
+// softAsserts collects results of the assertions run in the group
+type softAsserts struct {
+	sCtx     provider.StepCtx
+	step     *allure.Step
+	count    int
+	pending  []string
+	failures []string
+	traces   []string
+}
+
+// Step adds assertion step to the group's step
+func (s *softAsserts) Step(step *allure.Step) {
+	s.step.WithChild(step)
+	s.count++
+	if step.Status == allure.Failed {
+		s.failures = append(s.failures, step.Name)
+		s.traces = append(s.traces, fmt.Sprintf("%s:%s", step.Name, strings.Join(s.pending, "")))
+	}
+	s.pending = nil
+}
+
+// Errorf keeps failure message of the assertion until its step is added
+func (s *softAsserts) Errorf(format string, args ...interface{}) {
+	s.pending = append(s.pending, fmt.Sprintf(format, args...))
+}
+
+// FailNow is never called by assertions of the group, since they don't stop the test
+func (s *softAsserts) FailNow() {}
+
+// runSoftAsserts runs assertions of the group under the step of sCtx and reports failures of the group
+func runSoftAsserts(sCtx provider.StepCtx, assertions func(a provider.Asserts)) {
+	group := &softAsserts{sCtx: sCtx, step: sCtx.CurrentStep()}
+	assertions(helper.NewAssertsHelper(group))
+	if len(group.failures) == 0 {
+		return
+	}
+
+	msg := fmt.Sprintf("%d of %d soft assertions failed:\n%s", len(group.failures), group.count, strings.Join(group.failures, "\n"))
+	sCtx.CurrentStep().WithStatusDetails(msg, strings.Join(group.traces, "\n\n"))
+	switch *softAssertsMode {
+	case softAssertsFail:
+		sCtx.Errorf("%s", msg)
+	case softAssertsStop:
+		sCtx.Errorf("%s", msg)
+		sCtx.FailNow()
+	case softAssertsMark:
+		sCtx.CurrentStep().Failed()
+	default:
+		_, _ = fmt.Fprintf(os.Stderr, "allure-go: invalid value for -allure-go.soft-asserts: %s\n", *softAssertsMode)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,105 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/louisun/allure-go-v2/allure"
+	"github.com/louisun/allure-go-v2/framework/provider"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStepCtx_SoftAssert(t *testing.T) {
+	mockT := newStepProviderMock()
+	mockT.SetRealT(t)
+	ctx := stepCtx{t: mockT, currentStep: allure.NewSimpleStep("testStep")}
+
+	ctx.SoftAssert("Check response", func(a provider.Asserts) {
+		a.Equal(1, 1)
+		a.True(true)
+	})
+	require.False(t, mockT.failed)
+	require.Len(t, ctx.currentStep.Steps, 1)
+
+	step := ctx.currentStep.Steps[0]
+	require.Equal(t, "Check response", step.Name)
+	require.Equal(t, allure.Passed, step.Status)
+	require.Nil(t, step.StatusDetails)
+	require.Len(t, step.Steps, 2)
+}
+
+func TestStepCtx_SoftAssert_fail(t *testing.T) {
+	mockT := newStepProviderMock()
+	mockT.SetRealT(t)
+	ctx := stepCtx{t: mockT, currentStep: allure.NewSimpleStep("testStep")}
+
+	var completed bool
+	ctx.SoftAssert("Check response", func(a provider.Asserts) {
+		a.Equal(1, 2, "Code")
+		a.True(true)
+		a.Len([]int{1}, 2, "Items")
+		completed = true
+	})
+	require.True(t, completed)
+	require.True(t, mockT.errorF)
+	require.False(t, mockT.failNow)
+	require.Equal(t, allure.Failed, ctx.currentStep.Status)
+	require.Len(t, ctx.currentStep.Steps, 1)
+
+	step := ctx.currentStep.Steps[0]
+	require.Equal(t, allure.Failed, step.Status)
+	require.Len(t, step.Steps, 3)
+	require.Equal(t, allure.Failed, step.Steps[0].Status)
+	require.Equal(t, allure.Passed, step.Steps[1].Status)
+	require.Equal(t, allure.Failed, step.Steps[2].Status)
+
+	require.NotNil(t, step.StatusDetails)
+	require.Equal(t, "2 of 3 soft assertions failed:\nASSERT: Code\nASSERT: Items", step.StatusDetails.Message)
+	require.Contains(t, step.StatusDetails.Trace, "ASSERT: Code:")
+	require.Contains(t, step.StatusDetails.Trace, "ASSERT: Items:")
+	require.Contains(t, step.StatusDetails.Trace, "should have 2 item(s), but has 1")
+}
+
+func TestStepCtx_SoftAssert_percentInMessage(t *testing.T) {
+	mockT := newStepProviderMock()
+	mockT.SetRealT(t)
+	ctx := stepCtx{t: mockT, currentStep: allure.NewSimpleStep("testStep")}
+
+	ctx.SoftAssert("Check discount", func(a provider.Asserts) {
+		a.Equal(10, 20, "Discount 10%")
+	})
+	require.True(t, mockT.errorF)
+	require.Equal(t, "1 of 1 soft assertions failed:\nASSERT: Discount 10%", mockT.errMsg)
+}
+
+func TestStepCtx_SoftAssert_modes(t *testing.T) {
+	defer func(mode string) { *softAssertsMode = mode }(*softAssertsMode)
+
+	t.Run(softAssertsStop, func(t *testing.T) {
+		*softAssertsMode = softAssertsStop
+		mockT := newStepProviderMock()
+		mockT.SetRealT(t)
+		ctx := stepCtx{t: mockT, currentStep: allure.NewSimpleStep("testStep")}
+
+		ctx.SoftAssert("Check response", func(a provider.Asserts) {
+			a.Equal(1, 2)
+		})
+		require.True(t, mockT.errorF)
+		require.True(t, mockT.failNow)
+		require.Equal(t, allure.Failed, ctx.currentStep.Steps[0].Status)
+	})
+
+	t.Run(softAssertsMark, func(t *testing.T) {
+		*softAssertsMode = softAssertsMark
+		mockT := newStepProviderMock()
+		mockT.SetRealT(t)
+		ctx := stepCtx{t: mockT, currentStep: allure.NewSimpleStep("testStep")}
+
+		ctx.SoftAssert("Check response", func(a provider.Asserts) {
+			a.Equal(1, 2)
+		})
+		require.False(t, mockT.failed)
+		require.Equal(t, allure.Passed, ctx.currentStep.Status)
+		require.Equal(t, allure.Failed, ctx.currentStep.Steps[0].Status)
+		require.NotNil(t, ctx.currentStep.Steps[0].StatusDetails)
+	})
+}
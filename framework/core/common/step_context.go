@@ -1,9 +1,11 @@
 package common
 
 import (
+	"context"
 	"fmt"
 	"runtime/debug"
 	"sync"
+	"time"
 
 	"github.com/louisun/allure-go-v2/allure"
 	"github.com/louisun/allure-go-v2/framework/asserts_wrapper/helper"
@@ -28,6 +30,7 @@ type StepT interface {
 	BrokenNow()
 	Name() string
 	GetRealT() provider.TestingT
+	Context() context.Context
 }
 
 type InternalStepCtx interface {
@@ -35,6 +38,8 @@ type InternalStepCtx interface {
 
 	ExecutionContextName() string
 	WG() *sync.WaitGroup
+
+	cancelContext()
 }
 
 type stepCtx struct {
@@ -47,12 +52,14 @@ type stepCtx struct {
 	asserts provider.Asserts
 	require provider.Asserts
 
-	wg sync.WaitGroup
+	wg          sync.WaitGroup
+	stepContext stepContext
+	guard       *resultGuard
 }
 
 func NewStepCtx(t StepT, p StepProvider, stepName string, params ...*allure.Parameter) InternalStepCtx {
 	currentStep := allure.NewSimpleStep(stepName, params...)
-	newCtx := &stepCtx{t: t, p: p, currentStep: currentStep, wg: sync.WaitGroup{}}
+	newCtx := &stepCtx{t: t, p: p, currentStep: currentStep, wg: sync.WaitGroup{}, guard: guardOf(t)}
 	newCtx.asserts = helper.NewAssertsHelper(newCtx)
 	newCtx.require = helper.NewRequireHelper(newCtx)
 	return newCtx
@@ -60,7 +67,7 @@ func NewStepCtx(t StepT, p StepProvider, stepName string, params ...*allure.Para
 
 func (ctx *stepCtx) NewChildCtx(stepName string, params ...*allure.Parameter) InternalStepCtx {
 	currentStep := allure.NewSimpleStep(stepName, params...)
-	newCtx := &stepCtx{t: ctx.t, p: ctx.p, currentStep: currentStep, parentStep: ctx, wg: sync.WaitGroup{}}
+	newCtx := &stepCtx{t: ctx.t, p: ctx.p, currentStep: currentStep, parentStep: ctx, wg: sync.WaitGroup{}, guard: ctx.guard}
 	newCtx.asserts = helper.NewAssertsHelper(newCtx)
 	newCtx.require = helper.NewRequireHelper(newCtx)
 	return newCtx
@@ -78,6 +85,10 @@ func (ctx *stepCtx) Require() provider.Asserts {
 	return ctx.require
 }
 
+func (ctx *stepCtx) Expect(actual interface{}) provider.Expectation {
+	return ctx.asserts.Expect(actual)
+}
+
 func (ctx *stepCtx) WG() *sync.WaitGroup {
 	return &ctx.wg
 }
@@ -122,49 +133,47 @@ func (ctx *stepCtx) CurrentStep() *allure.Step {
 }
 
 func (ctx *stepCtx) WithParameters(parameters ...*allure.Parameter) {
-	ctx.currentStep.WithParameters(parameters...)
+	ctx.guard.do(func() { ctx.currentStep.WithParameters(parameters...) })
 }
 
 func (ctx *stepCtx) WithNewParameters(kv ...interface{}) {
-	ctx.currentStep.WithNewParameters(kv...)
+	ctx.guard.do(func() { ctx.currentStep.WithNewParameters(kv...) })
 }
 
 func (ctx *stepCtx) WithAttachments(attachments ...*allure.Attachment) {
-	ctx.currentStep.WithAttachments(attachments...)
+	ctx.guard.do(func() { ctx.currentStep.WithAttachments(attachments...) })
 }
 
 func (ctx *stepCtx) WithNewAttachment(name string, mimeType allure.MimeType, content []byte) {
-	ctx.currentStep.WithAttachments(allure.NewAttachment(name, mimeType, content))
+	ctx.WithAttachments(allure.NewAttachment(name, mimeType, content))
 }
 
 func (ctx *stepCtx) LogStep(args ...interface{}) {
-	newStep := allure.NewSimpleStep(fmt.Sprintln(args...))
-	ctx.currentStep.WithChild(newStep)
+	ctx.Step(allure.NewSimpleStep(fmt.Sprintln(args...)))
 	ctx.Log(args...)
 }
 
 func (ctx *stepCtx) LogfStep(format string, args ...interface{}) {
-	newStep := allure.NewSimpleStep(fmt.Sprintf(format, args...))
-	ctx.currentStep.WithChild(newStep)
+	ctx.Step(allure.NewSimpleStep(fmt.Sprintf(format, args...)))
 	ctx.Logf(format, args...)
 }
 
 func (ctx *stepCtx) Step(step *allure.Step) {
-	ctx.currentStep.WithChild(step)
+	ctx.guard.do(func() { ctx.currentStep.WithChild(step) })
 }
 
 func (ctx *stepCtx) NewStep(stepName string, parameters ...*allure.Parameter) {
-	newStep := allure.NewSimpleStep(stepName, parameters...)
-	ctx.currentStep.WithChild(newStep)
+	ctx.Step(allure.NewSimpleStep(stepName, parameters...))
 }
 
 func (ctx *stepCtx) WithNewStep(stepName string, step func(ctx provider.StepCtx), params ...*allure.Parameter) {
 	newCtx := ctx.NewChildCtx(stepName, params...)
-	defer ctx.currentStep.WithChild(newCtx.CurrentStep())
+	defer ctx.Step(newCtx.CurrentStep())
 	defer func() {
 		r := recover()
 		newCtx.WG().Wait()
-		newCtx.CurrentStep().Finish()
+		newCtx.cancelContext()
+		ctx.guard.do(func() { newCtx.CurrentStep().Finish() })
 		if r != nil {
 			ctxName := newCtx.ExecutionContextName()
 			errMsg := fmt.Sprintf("%s panicked: %v\n%s", ctxName, r, debug.Stack())
@@ -175,6 +184,24 @@ func (ctx *stepCtx) WithNewStep(stepName string, step func(ctx provider.StepCtx)
 	step(newCtx)
 }
 
+func (ctx *stepCtx) WithNewTimeoutStep(stepName string, timeout time.Duration, step func(ctx provider.StepCtx), params ...*allure.Parameter) {
+	ctx.WithNewStep(stepName, func(sCtx provider.StepCtx) {
+		runStepWithTimeout(ctx.t, sCtx, stepName, timeout, step)
+	}, params...)
+}
+
+func (ctx *stepCtx) WithEventuallyStep(stepName string, timeout, interval time.Duration, condition func(ctx provider.StepCtx) bool, params ...*allure.Parameter) {
+	ctx.WithNewStep(stepName, func(sCtx provider.StepCtx) {
+		runEventually(sCtx, stepName, timeout, interval, condition)
+	}, params...)
+}
+
+func (ctx *stepCtx) SoftAssert(name string, assertions func(a provider.Asserts)) {
+	ctx.WithNewStep(name, func(sCtx provider.StepCtx) {
+		runSoftAsserts(sCtx, assertions)
+	})
+}
+
 func (ctx *stepCtx) WithNewAsyncStep(stepName string, step func(ctx provider.StepCtx), params ...*allure.Parameter) {
 	var wg *sync.WaitGroup
 	wg = &ctx.wg
@@ -190,14 +217,14 @@ func (ctx *stepCtx) WithNewAsyncStep(stepName string, step func(ctx provider.Ste
 }
 
 func (ctx *stepCtx) Fail() {
-	ctx.currentStep.Failed()
+	ctx.guard.do(func() { ctx.currentStep.Failed() })
 	if ctx.parentStep != nil {
 		ctx.parentStep.Fail()
 	}
 }
 
 func (ctx *stepCtx) Broken() {
-	ctx.currentStep.Broken()
+	ctx.guard.do(func() { ctx.currentStep.Broken() })
 	if ctx.parentStep != nil {
 		ctx.parentStep.Broken()
 	}
@@ -205,7 +232,7 @@ func (ctx *stepCtx) Broken() {
 }
 
 func (ctx *stepCtx) BrokenNow() {
-	ctx.currentStep.Broken()
+	ctx.guard.do(func() { ctx.currentStep.Broken() })
 	if ctx.parentStep != nil {
 		ctx.parentStep.Broken()
 	}
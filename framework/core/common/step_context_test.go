@@ -1,6 +1,8 @@
 package common
 
 import (
+	"context"
+	"fmt"
 	"sync"
 	"testing"
 	"time"
@@ -17,6 +19,7 @@ type providerTMockStep struct {
 	steps   []*allure.Step
 	error   bool
 	errorF  bool
+	errMsg  string
 	log     bool
 	logf    bool
 	failNow bool
@@ -51,6 +54,7 @@ func (m *providerTMockStep) Step(step *allure.Step) {
 }
 
 func (m *providerTMockStep) Errorf(format string, args ...interface{}) {
+	m.errMsg = fmt.Sprintf(format, args...)
 	m.errorF = true
 	m.failed = true
 }
@@ -89,6 +93,10 @@ func (m *providerTMockStep) GetRealT() provider.TestingT {
 	return m.testingT
 }
 
+func (m *providerTMockStep) Context() context.Context {
+	return context.Background()
+}
+
 func (m *providerTMockStep) SetRealT(realT provider.TestingT) {
 	m.testingT = realT
 }
@@ -306,6 +314,22 @@ func TestStepCtx_Require(t *testing.T) {
 	require.Equal(t, test, ctx.Require())
 }
 
+func TestStepCtx_Expect(t *testing.T) {
+	mockT := newStepProviderMock()
+	mockT.SetRealT(t)
+	ctx := &stepCtx{t: mockT, currentStep: allure.NewSimpleStep("testStep")}
+	ctx.asserts = helper.NewAssertsHelper(ctx)
+
+	ctx.Expect(404).ToEqual(200).NotToEqual(500)
+	require.True(t, mockT.errorF)
+	require.False(t, mockT.failNow)
+	require.Len(t, ctx.currentStep.Steps, 2)
+	require.Equal(t, "ASSERT: Equal", ctx.currentStep.Steps[0].Name)
+	require.Equal(t, allure.Failed, ctx.currentStep.Steps[0].Status)
+	require.Equal(t, "ASSERT: Not Equal", ctx.currentStep.Steps[1].Name)
+	require.Equal(t, allure.Passed, ctx.currentStep.Steps[1].Status)
+}
+
 func TestStepCtx_WG(t *testing.T) {
 	test := sync.WaitGroup{}
 	ctx := stepCtx{wg: test}
@@ -11,17 +11,18 @@ import (
 // WithNewStep opens nesting for struct.Step
 // Any other struct.Step that will be added to struct.AllureResult object will be added as child step
 func (c *Common) WithNewStep(stepName string, step func(ctx provider.StepCtx), params ...*allure.Parameter) {
-	stCtx := NewStepCtx(c, c.Provider, stepName, params...)
+	stCtx := NewStepCtx(c, c, stepName, params...)
 	defer c.Step(stCtx.CurrentStep())
 	defer func() {
 		r := recover()
 		stCtx.WG().Wait()
-		stCtx.CurrentStep().Finish()
+		stCtx.cancelContext()
+		c.guard.do(func() { stCtx.CurrentStep().Finish() })
 		if r != nil {
 			ctxName := c.ExecutionContext().GetName()
 			errMsg := fmt.Sprintf("%s panicked: %v\n%s", ctxName, r, debug.Stack())
 			stCtx.Broken()
-			TestError(c.TestingT, c.Provider, c.Provider.ExecutionContext().GetName(), errMsg)
+			TestError(c.TestingT, c, c.Provider.ExecutionContext().GetName(), errMsg)
 		}
 	}()
 	step(stCtx)
@@ -88,7 +88,7 @@ type suiteMetaMockstepsCommon struct {
 	namePrefix string
 	name       string
 	container  *allure.Container
-	hook       func(t provider.T)
+	hook       []provider.Hook
 }
 
 func (m *suiteMetaMockstepsCommon) GetPackageName() string {
@@ -115,27 +115,27 @@ func (m *suiteMetaMockstepsCommon) GetContainer() *allure.Container {
 	return m.container
 }
 
-func (m *suiteMetaMockstepsCommon) SetBeforeAll(hook func(provider.T)) {
-	m.hook = hook
+func (m *suiteMetaMockstepsCommon) SetBeforeAll(hooks ...provider.Hook) {
+	m.hook = hooks
 }
 
-func (m *suiteMetaMockstepsCommon) SetAfterAll(hook func(provider.T)) {
-	m.hook = hook
+func (m *suiteMetaMockstepsCommon) SetAfterAll(hooks ...provider.Hook) {
+	m.hook = hooks
 }
 
-func (m *suiteMetaMockstepsCommon) GetBeforeAll() func(provider.T) {
+func (m *suiteMetaMockstepsCommon) GetBeforeAll() []provider.Hook {
 	return m.hook
 }
 
-func (m *suiteMetaMockstepsCommon) GetAfterAll() func(provider.T) {
+func (m *suiteMetaMockstepsCommon) GetAfterAll() []provider.Hook {
 	return m.hook
 }
 
 type testMetaMockstepsCommon struct {
 	result    *allure.Result
 	container *allure.Container
-	be        func(t provider.T)
-	ae        func(t provider.T)
+	be        []provider.Hook
+	ae        []provider.Hook
 }
 
 func (m *testMetaMockstepsCommon) GetResult() *allure.Result {
@@ -150,19 +150,19 @@ func (m *testMetaMockstepsCommon) GetContainer() *allure.Container {
 	return m.container
 }
 
-func (m *testMetaMockstepsCommon) SetBeforeEach(hook func(t provider.T)) {
-	m.be = hook
+func (m *testMetaMockstepsCommon) SetBeforeEach(hooks ...provider.Hook) {
+	m.be = hooks
 }
 
-func (m *testMetaMockstepsCommon) GetBeforeEach() func(t provider.T) {
+func (m *testMetaMockstepsCommon) GetBeforeEach() []provider.Hook {
 	return m.be
 }
 
-func (m *testMetaMockstepsCommon) SetAfterEach(hook func(t provider.T)) {
-	m.ae = hook
+func (m *testMetaMockstepsCommon) SetAfterEach(hooks ...provider.Hook) {
+	m.ae = hooks
 }
 
-func (m *testMetaMockstepsCommon) GetAfterEach() func(t provider.T) {
+func (m *testMetaMockstepsCommon) GetAfterEach() []provider.Hook {
 	return m.ae
 }
 
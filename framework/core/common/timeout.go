@@ -0,0 +1,403 @@
+package common
+
+import (
+	"fmt"
+	"runtime"
+	"runtime/debug"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/louisun/allure-go-v2/allure"
+	"github.com/louisun/allure-go-v2/framework/provider"
+)
+
+const goroutinesAttachmentName = "Goroutines"
+
+// testTimeout describes deadline of the test body
+type testTimeout struct {
+	mu      sync.Mutex
+	timeout time.Duration
+	timer   *time.Timer
+	expired chan struct{}
+	once    sync.Once
+}
+
+func newTestTimeout() *testTimeout {
+	return &testTimeout{expired: make(chan struct{})}
+}
+
+// set restarts deadline. Non-positive timeout removes the deadline
+func (tt *testTimeout) set(timeout time.Duration) {
+	tt.mu.Lock()
+	defer tt.mu.Unlock()
+
+	tt.timeout = timeout
+	tt.restart()
+}
+
+// pause stops deadline timer until resume is called
+func (tt *testTimeout) pause() {
+	tt.mu.Lock()
+	defer tt.mu.Unlock()
+
+	if tt.timer != nil {
+		tt.timer.Stop()
+	}
+}
+
+// resume restarts deadline timer with current timeout
+func (tt *testTimeout) resume() {
+	tt.mu.Lock()
+	defer tt.mu.Unlock()
+
+	tt.restart()
+}
+
+func (tt *testTimeout) restart() {
+	if tt.timer != nil {
+		tt.timer.Stop()
+		tt.timer = nil
+	}
+	if tt.timeout > 0 {
+		tt.timer = time.AfterFunc(tt.timeout, func() {
+			tt.once.Do(func() { close(tt.expired) })
+		})
+	}
+}
+
+func (tt *testTimeout) getTimeout() time.Duration {
+	tt.mu.Lock()
+	defer tt.mu.Unlock()
+
+	return tt.timeout
+}
+
+// WithTimeout sets deadline of the test body. Deadline is counted from the moment of call.
+// When deadline expires test is marked broken and its goroutine is abandoned.
+// Non-positive timeout removes the deadline. Deadline may be set from the body itself.
+func (c *Common) WithTimeout(timeout time.Duration) {
+	if c.timeout != nil {
+		c.timeout.set(timeout)
+	}
+}
+
+// Parallel pauses test's deadline while test waits for its turn to run in parallel
+func (c *Common) Parallel() {
+	if c.timeout != nil {
+		c.timeout.pause()
+		defer c.timeout.resume()
+	}
+	c.TestingT.Parallel()
+}
+
+// ExecuteWithTimeout runs test body in separate goroutine and waits until body is done or test's deadline expires.
+// If deadline expires, test is marked broken, goroutines dump is attached to the test result
+// and body's goroutine is abandoned. Returns false in case of timeout.
+// Body is watched even if test has no deadline yet, so deadline set later by WithTimeout is respected.
+// Panics and runtime.Goexit calls of the body are passed to the caller's goroutine.
+func (c *Common) ExecuteWithTimeout(body func()) bool {
+	if c.timeout == nil {
+		body()
+		return true
+	}
+	var (
+		done     = make(chan struct{})
+		finished bool
+		rec      interface{}
+	)
+	go func() {
+		defer close(done)
+		defer func() {
+			if !finished {
+				rec = recover()
+			}
+		}()
+		body()
+		finished = true
+	}()
+
+	select {
+	case <-done:
+		c.timeout.pause()
+		if rec != nil {
+			panic(rec)
+		}
+		if !finished {
+			runtime.Goexit()
+		}
+		return true
+	case <-c.timeout.expired:
+		errMsg := fmt.Sprintf("Test timed out after %s", c.timeout.getTimeout())
+		c.WithAttachments(goroutinesAttachment())
+		c.StopResult(allure.Broken)
+		c.UpdateResultStatus(errMsg, errMsg)
+		c.TestingT.Errorf("%s", errMsg)
+		c.ctx.cancelContext()
+		c.abandon()
+		return false
+	}
+}
+
+// WithNewTimeoutStep opens nesting for struct.Step like WithNewStep, but bounds step execution with timeout.
+// If timeout expires, step and test are marked broken and test stops. Step's goroutine is abandoned.
+func (c *Common) WithNewTimeoutStep(stepName string, timeout time.Duration, step func(ctx provider.StepCtx), params ...*allure.Parameter) {
+	c.WithNewStep(stepName, func(sCtx provider.StepCtx) {
+		runStepWithTimeout(c, sCtx, stepName, timeout, step)
+	}, params...)
+}
+
+// runStepWithTimeout runs step body in separate goroutine and marks step broken if timeout expires.
+// Panics and runtime.Goexit calls of the step body are passed to the caller's goroutine.
+func runStepWithTimeout(t StepT, sCtx provider.StepCtx, stepName string, timeout time.Duration, step func(ctx provider.StepCtx)) {
+	if timeout <= 0 {
+		step(sCtx)
+		return
+	}
+	var (
+		done     = make(chan struct{})
+		finished bool
+		rec      interface{}
+	)
+	go func() {
+		defer close(done)
+		defer func() {
+			if r := recover(); r != nil {
+				rec = fmt.Sprintf("%v\n%s", r, debug.Stack())
+			}
+		}()
+		step(sCtx)
+		finished = true
+	}()
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	select {
+	case <-done:
+		if rec != nil {
+			panic(rec)
+		}
+		if !finished {
+			runtime.Goexit()
+		}
+	case <-timer.C:
+		errMsg := fmt.Sprintf("Step %q timed out after %s", stepName, timeout)
+		sCtx.WithAttachments(goroutinesAttachment())
+		if a, ok := t.(abandoner); ok {
+			a.abandon()
+		}
+		sCtx.Log(errMsg)
+		sCtx.Break(errMsg)
+	}
+}
+
+type abandoner interface {
+	abandon()
+}
+
+// abandon makes testing.T of the test ignore calls made after the test is completed.
+// It protects test binary from panic if abandoned goroutine calls testing.T methods after the test end.
+// Changes of the test result made by abandoned goroutine are ignored once the result is sealed.
+func (c *Common) abandon() {
+	t, ok := c.TestingT.(*guardedT)
+	if !ok || !atomic.CompareAndSwapInt32(&t.abandoned, 0, 1) {
+		return
+	}
+	t.TestingT.Cleanup(t.complete)
+}
+
+func goroutinesAttachment() *allure.Attachment {
+	buf := make([]byte, 1<<20)
+	buf = buf[:runtime.Stack(buf, true)]
+	return allure.NewAttachment(goroutinesAttachmentName, allure.Text, buf)
+}
+
+// guardedT forwards calls to testing.T. Once the test's goroutine is abandoned, calls stopping the goroutine
+// don't reach testing.T, and all calls are ignored after the test is completed.
+type guardedT struct {
+	provider.TestingT
+
+	abandoned int32
+
+	// mu is held while call is forwarded, so testing.T isn't completed in the middle of the call
+	mu        sync.RWMutex
+	completed bool
+}
+
+func newGuardedT(realT provider.TestingT) provider.TestingT {
+	if t, ok := realT.(*guardedT); ok {
+		return t
+	}
+	return &guardedT{TestingT: realT}
+}
+
+// unguarded returns testing.T wrapped by guardedT
+func unguarded(t provider.TestingT) provider.TestingT {
+	if g, ok := t.(*guardedT); ok {
+		return g.TestingT
+	}
+	return t
+}
+
+func (t *guardedT) isAbandoned() bool {
+	return atomic.LoadInt32(&t.abandoned) == 1
+}
+
+// complete makes guard ignore further calls. It waits for forwarded calls to return.
+func (t *guardedT) complete() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.completed = true
+}
+
+// Fail ...
+func (t *guardedT) Fail() {
+	t.TestingT.Helper()
+
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	if !t.completed {
+		t.TestingT.Fail()
+	}
+}
+
+// FailNow ...
+func (t *guardedT) FailNow() {
+	t.TestingT.Helper()
+
+	if !t.isAbandoned() {
+		t.TestingT.FailNow()
+		return
+	}
+	t.Fail()
+	runtime.Goexit()
+}
+
+// Error ...
+func (t *guardedT) Error(args ...interface{}) {
+	t.TestingT.Helper()
+
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	if !t.completed {
+		t.TestingT.Error(args...)
+	}
+}
+
+// Errorf ...
+func (t *guardedT) Errorf(format string, args ...interface{}) {
+	t.TestingT.Helper()
+
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	if !t.completed {
+		t.TestingT.Errorf(format, args...)
+	}
+}
+
+// Fatal ...
+func (t *guardedT) Fatal(args ...interface{}) {
+	t.TestingT.Helper()
+
+	if !t.isAbandoned() {
+		t.TestingT.Fatal(args...)
+		return
+	}
+	t.Error(args...)
+	runtime.Goexit()
+}
+
+// Fatalf ...
+func (t *guardedT) Fatalf(format string, args ...interface{}) {
+	t.TestingT.Helper()
+
+	if !t.isAbandoned() {
+		t.TestingT.Fatalf(format, args...)
+		return
+	}
+	t.Errorf(format, args...)
+	runtime.Goexit()
+}
+
+// Log ...
+func (t *guardedT) Log(args ...interface{}) {
+	t.TestingT.Helper()
+
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	if !t.completed {
+		t.TestingT.Log(args...)
+	}
+}
+
+// Logf ...
+func (t *guardedT) Logf(format string, args ...interface{}) {
+	t.TestingT.Helper()
+
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	if !t.completed {
+		t.TestingT.Logf(format, args...)
+	}
+}
+
+// Skip ...
+func (t *guardedT) Skip(args ...interface{}) {
+	t.TestingT.Helper()
+
+	if !t.isAbandoned() {
+		t.TestingT.Skip(args...)
+		return
+	}
+	runtime.Goexit()
+}
+
+// Skipf ...
+func (t *guardedT) Skipf(format string, args ...interface{}) {
+	t.TestingT.Helper()
+
+	if !t.isAbandoned() {
+		t.TestingT.Skipf(format, args...)
+		return
+	}
+	runtime.Goexit()
+}
+
+// SkipNow ...
+func (t *guardedT) SkipNow() {
+	t.TestingT.Helper()
+
+	if !t.isAbandoned() {
+		t.TestingT.SkipNow()
+		return
+	}
+	runtime.Goexit()
+}
+
+// Parallel ...
+func (t *guardedT) Parallel() {
+	if !t.isAbandoned() {
+		t.TestingT.Parallel()
+	}
+}
+
+// Deadline ...
+func (t *guardedT) Deadline() (time.Time, bool) {
+	if d, ok := t.TestingT.(deadliner); ok {
+		return d.Deadline()
+	}
+	return time.Time{}, false
+}
+
+// Run ...
+func (t *guardedT) Run(testName string, testBody func(t *testing.T)) bool {
+	t.mu.RLock()
+	completed := t.completed
+	t.mu.RUnlock()
+	if completed {
+		return false
+	}
+	return t.TestingT.Run(testName, testBody)
+}
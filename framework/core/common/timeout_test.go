@@ -0,0 +1,250 @@
+package common
+
+import (
+	"os"
+	"os/exec"
+	"regexp"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/louisun/allure-go-v2/allure"
+	"github.com/louisun/allure-go-v2/framework/provider"
+	"github.com/stretchr/testify/require"
+)
+
+type providerMockTimeout struct {
+	*providerMockCommon
+
+	status      allure.Status
+	msg         string
+	attachments []*allure.Attachment
+}
+
+func (m *providerMockTimeout) WithAttachments(attachments ...*allure.Attachment) {
+	m.attachments = append(m.attachments, attachments...)
+}
+
+func (m *providerMockTimeout) StopResult(status allure.Status) {
+	m.status = status
+}
+
+func (m *providerMockTimeout) UpdateResultStatus(msg string, trace string) {
+	m.msg = msg
+}
+
+type commonTMockTimeout struct {
+	*commonTMock
+
+	cleanup []func()
+}
+
+func (m *commonTMockTimeout) Cleanup(f func()) {
+	m.cleanup = append(m.cleanup, f)
+}
+
+func TestCommon_ExecuteWithTimeout(t *testing.T) {
+	mockT := &commonTMockTimeout{commonTMock: newCommonTMock()}
+	providerMock := &providerMockTimeout{providerMockCommon: newProviderMockCommon("name", "fullName")}
+	comm := &Common{TestingT: mockT, Provider: providerMock, timeout: newTestTimeout()}
+
+	comm.WithTimeout(time.Second)
+	ok := comm.ExecuteWithTimeout(func() {})
+
+	require.True(t, ok)
+	require.False(t, mockT.errorfFlag)
+	require.Empty(t, providerMock.status)
+	require.Equal(t, mockT, comm.TestingT)
+}
+
+func TestCommon_ExecuteWithTimeout_expired(t *testing.T) {
+	mockT := &commonTMockTimeout{commonTMock: newCommonTMock()}
+	providerMock := &providerMockTimeout{providerMockCommon: newProviderMockCommon("name", "fullName")}
+	comm := &Common{TestingT: newGuardedT(mockT), Provider: providerMock, timeout: newTestTimeout()}
+
+	release := make(chan struct{})
+	defer close(release)
+
+	comm.WithTimeout(10 * time.Millisecond)
+	ok := comm.ExecuteWithTimeout(func() { <-release })
+
+	require.False(t, ok)
+	require.True(t, mockT.errorfFlag)
+	require.Equal(t, allure.Broken, providerMock.status)
+	require.Equal(t, "Test timed out after 10ms", providerMock.msg)
+	require.Len(t, providerMock.attachments, 1)
+	require.Equal(t, goroutinesAttachmentName, providerMock.attachments[0].Name)
+	require.Equal(t, allure.Text, providerMock.attachments[0].Type)
+
+	guarded, isGuarded := comm.TestingT.(*guardedT)
+	require.True(t, isGuarded)
+	require.True(t, guarded.isAbandoned())
+	require.Equal(t, mockT, comm.RealT())
+	require.Len(t, mockT.cleanup, 1)
+
+	mockT.errorfFlag = false
+	mockT.cleanup[0]()
+	guarded.Errorf("late error")
+	require.False(t, mockT.errorfFlag)
+}
+
+func TestCommon_ExecuteWithTimeout_noTimeout(t *testing.T) {
+	comm := &Common{TestingT: newCommonTMock(), timeout: newTestTimeout()}
+
+	var done bool
+	ok := comm.ExecuteWithTimeout(func() { done = true })
+	require.True(t, ok)
+	require.True(t, done)
+
+	// panic of the body is passed to the caller's goroutine
+	require.PanicsWithValue(t, "body", func() {
+		comm.ExecuteWithTimeout(func() { panic("body") })
+	})
+}
+
+func TestCommon_ExecuteWithTimeout_setFromBody(t *testing.T) {
+	mockT := &commonTMockTimeout{commonTMock: newCommonTMock()}
+	providerMock := &providerMockTimeout{providerMockCommon: newProviderMockCommon("name", "fullName")}
+	comm := &Common{TestingT: newGuardedT(mockT), Provider: providerMock, timeout: newTestTimeout()}
+
+	release := make(chan struct{})
+	defer close(release)
+
+	ok := comm.ExecuteWithTimeout(func() {
+		comm.WithTimeout(10 * time.Millisecond)
+		<-release
+	})
+
+	require.False(t, ok)
+	require.True(t, mockT.errorfFlag)
+	require.Equal(t, allure.Broken, providerMock.status)
+	require.Equal(t, "Test timed out after 10ms", providerMock.msg)
+}
+
+func TestCommon_guardedT(t *testing.T) {
+	mockT := &commonTMockTimeout{commonTMock: newCommonTMock()}
+	comm := NewT(mockT)
+	require.Equal(t, mockT, comm.RealT())
+
+	comm.Parallel()
+	require.True(t, mockT.parallel)
+
+	comm.abandon()
+	require.Len(t, mockT.cleanup, 1)
+	mockT.parallel = false
+	comm.Parallel()
+	require.False(t, mockT.parallel)
+}
+
+func TestCommon_guardedT_helper(t *testing.T) {
+	if os.Getenv("ALLURE_GO_GUARDED_T_HELPER") == "1" {
+		guarded := newGuardedT(t)
+		_, _, line, _ := runtime.Caller(0)
+		guarded.Errorf("error reported at %d", line+1)
+		_, _, line, _ = runtime.Caller(0)
+		guarded.Logf("log reported at %d", line+1)
+		return
+	}
+
+	// reported location is only visible in the output of the test binary
+	cmd := exec.Command(os.Args[0], "-test.run=^TestCommon_guardedT_helper$", "-test.v")
+	cmd.Env = append(os.Environ(), "ALLURE_GO_GUARDED_T_HELPER=1")
+	out, err := cmd.CombinedOutput()
+	require.Error(t, err)
+
+	matches := regexp.MustCompile(`timeout_test\.go:(\d+): \w+ reported at (\d+)`).FindAllStringSubmatch(string(out), -1)
+	require.Len(t, matches, 2, string(out))
+	for _, m := range matches {
+		require.Equal(t, m[2], m[1], string(out))
+	}
+}
+
+func TestCommon_Seal(t *testing.T) {
+	mockT := &commonTMockTimeout{commonTMock: newCommonTMock()}
+	providerMock := &providerMockTimeout{providerMockCommon: newProviderMockCommon("name", "fullName")}
+	comm := NewT(mockT)
+	comm.SetProvider(providerMock)
+
+	comm.WithAttachments(allure.NewAttachment("before", allure.Text, nil))
+	comm.Seal()
+	comm.WithAttachments(allure.NewAttachment("after", allure.Text, nil))
+	comm.StopResult(allure.Broken)
+
+	require.Len(t, providerMock.attachments, 1)
+	require.Equal(t, "before", providerMock.attachments[0].Name)
+	require.Empty(t, providerMock.status)
+}
+
+func TestCommon_Detached(t *testing.T) {
+	mockT := &commonTMockTimeout{commonTMock: newCommonTMock()}
+	providerMock := &providerMockTimeout{providerMockCommon: newProviderMockCommon("name", "fullName")}
+	comm := NewT(mockT)
+	comm.SetProvider(providerMock)
+	require.Same(t, comm, comm.Detached())
+
+	comm.abandon()
+	detached := comm.Detached()
+	require.NotSame(t, comm, detached)
+	require.Equal(t, mockT, detached.RealT())
+	require.Equal(t, comm.GetProvider(), detached.GetProvider())
+
+	// detached test shares the result guard with the abandoned one
+	detached.Seal()
+	comm.StopResult(allure.Broken)
+	require.Empty(t, providerMock.status)
+}
+
+func TestCommon_Parallel_pausesTimeout(t *testing.T) {
+	mockT := newCommonTMock()
+	comm := &Common{TestingT: mockT, timeout: newTestTimeout()}
+	comm.WithTimeout(time.Minute)
+	comm.Parallel()
+
+	require.True(t, mockT.parallel)
+	require.Equal(t, time.Minute, comm.timeout.getTimeout())
+}
+
+func TestStepCtx_WithNewTimeoutStep(t *testing.T) {
+	mockT := newStepProviderMock()
+	ctx := stepCtx{t: mockT, currentStep: allure.NewSimpleStep("testStep")}
+
+	var flag bool
+	ctx.WithNewTimeoutStep("new step", time.Second, func(sCtx provider.StepCtx) {
+		flag = true
+	})
+	require.True(t, flag)
+	require.False(t, mockT.failed)
+	require.Len(t, ctx.currentStep.Steps, 1)
+	require.Equal(t, allure.Passed, ctx.currentStep.Steps[0].Status)
+}
+
+func TestStepCtx_WithNewTimeoutStep_expired(t *testing.T) {
+	mockT := newStepProviderMock()
+	ctx := stepCtx{t: mockT, p: &providerMockStep{executionContext: newExecutionCtxMock("test")}, currentStep: allure.NewSimpleStep("testStep")}
+
+	release := make(chan struct{})
+	defer close(release)
+
+	ctx.WithNewTimeoutStep("new step", 10*time.Millisecond, func(sCtx provider.StepCtx) {
+		<-release
+	})
+	require.True(t, mockT.failed)
+	require.Len(t, ctx.currentStep.Steps, 1)
+
+	step := ctx.currentStep.Steps[0]
+	require.Equal(t, allure.Broken, step.Status)
+	require.Len(t, step.Attachments, 1)
+	require.Equal(t, goroutinesAttachmentName, step.Attachments[0].Name)
+}
+
+func TestStepCtx_WithNewTimeoutStep_panic(t *testing.T) {
+	mockT := newStepProviderMock()
+	ctx := stepCtx{t: mockT, p: &providerMockStep{executionContext: newExecutionCtxMock("test")}, currentStep: allure.NewSimpleStep("testStep")}
+
+	ctx.WithNewTimeoutStep("new step", time.Second, func(sCtx provider.StepCtx) {
+		panic("whoops")
+	})
+	require.True(t, mockT.errorF)
+	require.True(t, mockT.failNow)
+	require.Len(t, ctx.currentStep.Steps, 1)
+}
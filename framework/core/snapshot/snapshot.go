@@ -0,0 +1,148 @@
+package snapshot
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Dir is the directory of the package, which snapshots are stored in
+const Dir = "testdata/__snapshots__"
+
+var (
+	updateSnapshots = flag.Bool("allure-go.update-snapshots", false, "rewrite snapshots of the allure-go tests with actual values")
+
+	mu         sync.Mutex
+	referenced = make(map[string]bool)
+	suites     = make(map[string]bool)
+)
+
+// Result describes comparison of the value with its snapshot
+type Result struct {
+	Path     string
+	Expected []byte
+	Actual   []byte
+	// JSON is true if the value is serialized as JSON
+	JSON    bool
+	Matched bool
+	// Updated is true if snapshot is written by -allure-go.update-snapshots
+	Updated bool
+}
+
+// Path returns path of the snapshot of the suite's test relative to the package directory
+func Path(suite, test, name string) string {
+	return filepath.Join(Dir, escape(suite), escape(test), escape(name))
+}
+
+// Serialize returns content of the value's snapshot. Strings and []byte are kept as is,
+// other values are encoded to indented JSON.
+func Serialize(value interface{}) (content []byte, isJSON bool, err error) {
+	switch v := value.(type) {
+	case []byte:
+		return v, false, nil
+	case string:
+		return []byte(v), false, nil
+	}
+	content, err = json.MarshalIndent(value, "", "  ")
+	if err != nil {
+		return nil, true, err
+	}
+	return append(content, '\n'), true, nil
+}
+
+// Match compares the value with its snapshot. With -allure-go.update-snapshots flag snapshot is rewritten with the value.
+// Missing snapshot is an error unless it's written by the flag.
+func Match(suite, test, name string, value interface{}) (*Result, error) {
+	path := Path(suite, test, name)
+	reference(suite, path)
+
+	actual, isJSON, err := Serialize(value)
+	if err != nil {
+		return nil, fmt.Errorf("value of snapshot %s can't be serialized: %s", path, err)
+	}
+	result := &Result{Path: path, Actual: actual, JSON: isJSON}
+
+	if *updateSnapshots {
+		if err = os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			return nil, err
+		}
+		if err = os.WriteFile(path, actual, 0o644); err != nil {
+			return nil, err
+		}
+		result.Expected, result.Matched, result.Updated = actual, true, true
+		return result, nil
+	}
+
+	result.Expected, err = os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, fmt.Errorf("snapshot %s doesn't exist, run tests with -allure-go.update-snapshots flag to create it", path)
+	}
+	if err != nil {
+		return nil, err
+	}
+	result.Matched = bytes.Equal(result.Expected, result.Actual)
+	return result, nil
+}
+
+// Unreferenced returns snapshots of the suites, which matched at least one snapshot in this run, that no test referenced.
+// Snapshots of the suites are reliable only if all tests of the suites were run.
+func Unreferenced() []string {
+	mu.Lock()
+	defer mu.Unlock()
+
+	var unreferenced []string
+	for suiteDir := range suites {
+		_ = filepath.WalkDir(suiteDir, func(path string, d fs.DirEntry, err error) error {
+			if err == nil && !d.IsDir() && !referenced[path] {
+				unreferenced = append(unreferenced, path)
+			}
+			return nil
+		})
+	}
+	sort.Strings(unreferenced)
+	return unreferenced
+}
+
+// WarnUnreferenced writes the list of snapshots no test referenced to w.
+// Returns false if there are such snapshots.
+func WarnUnreferenced(w io.Writer) bool {
+	unreferenced := Unreferenced()
+	if len(unreferenced) == 0 {
+		return true
+	}
+	_, _ = fmt.Fprintf(w, "allure-go: %d snapshots are not referenced by any test:\n", len(unreferenced))
+	for _, path := range unreferenced {
+		_, _ = fmt.Fprintf(w, "\t%s\n", path)
+	}
+	return false
+}
+
+func reference(suite, path string) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	referenced[path] = true
+	suites[filepath.Join(Dir, escape(suite))] = true
+}
+
+// escape makes the name safe to be used as the file name
+func escape(name string) string {
+	if name == "" || name == "." || name == ".." {
+		return "_"
+	}
+	return strings.Map(func(r rune) rune {
+		switch r {
+		case '/', '\\', ':', '*', '?', '"', '<', '>', '|', '\x00':
+			return '_'
+		}
+		return r
+	}, name)
+}
@@ -0,0 +1,103 @@
+package snapshot
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type snapshotUser struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+// inTempDir runs the test in the temporary package directory with clean registry of snapshots
+func inTempDir(t *testing.T) {
+	wd, err := os.Getwd()
+	require.NoError(t, err)
+	require.NoError(t, os.Chdir(t.TempDir()))
+
+	mu.Lock()
+	referenced, suites = make(map[string]bool), make(map[string]bool)
+	mu.Unlock()
+	t.Cleanup(func() {
+		_ = os.Chdir(wd)
+		*updateSnapshots = false
+	})
+}
+
+func TestPath(t *testing.T) {
+	require.Equal(t, filepath.Join(Dir, "Suite", "TestA_case 1", "user.json"), Path("Suite", "TestA/case 1", "user.json"))
+	require.Equal(t, filepath.Join(Dir, "_", "TestA", "_"), Path("", "TestA", ".."))
+}
+
+func TestSerialize(t *testing.T) {
+	content, isJSON, err := Serialize("raw\ntext")
+	require.NoError(t, err)
+	require.False(t, isJSON)
+	require.Equal(t, "raw\ntext", string(content))
+
+	content, isJSON, err = Serialize([]byte{1, 2})
+	require.NoError(t, err)
+	require.False(t, isJSON)
+	require.Equal(t, []byte{1, 2}, content)
+
+	content, isJSON, err = Serialize(snapshotUser{ID: 1, Name: "John"})
+	require.NoError(t, err)
+	require.True(t, isJSON)
+	require.Equal(t, "{\n  \"id\": 1,\n  \"name\": \"John\"\n}\n", string(content))
+
+	_, _, err = Serialize(make(chan int))
+	require.Error(t, err)
+}
+
+func TestMatch(t *testing.T) {
+	inTempDir(t)
+
+	_, err := Match("Suite", "TestA", "user", snapshotUser{ID: 1, Name: "John"})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "-allure-go.update-snapshots")
+
+	*updateSnapshots = true
+	result, err := Match("Suite", "TestA", "user", snapshotUser{ID: 1, Name: "John"})
+	require.NoError(t, err)
+	require.True(t, result.Matched)
+	require.True(t, result.Updated)
+	require.FileExists(t, Path("Suite", "TestA", "user"))
+
+	*updateSnapshots = false
+	result, err = Match("Suite", "TestA", "user", snapshotUser{ID: 1, Name: "John"})
+	require.NoError(t, err)
+	require.True(t, result.Matched)
+	require.False(t, result.Updated)
+	require.True(t, result.JSON)
+
+	result, err = Match("Suite", "TestA", "user", snapshotUser{ID: 2, Name: "John"})
+	require.NoError(t, err)
+	require.False(t, result.Matched)
+	require.Equal(t, "{\n  \"id\": 1,\n  \"name\": \"John\"\n}\n", string(result.Expected))
+	require.Equal(t, "{\n  \"id\": 2,\n  \"name\": \"John\"\n}\n", string(result.Actual))
+}
+
+func TestWarnUnreferenced(t *testing.T) {
+	inTempDir(t)
+
+	for _, path := range []string{Path("Suite", "TestA", "user"), Path("Suite", "TestB", "old"), Path("Other", "TestC", "user")} {
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+		require.NoError(t, os.WriteFile(path, []byte("snapshot"), 0o644))
+	}
+	buf := &bytes.Buffer{}
+	require.True(t, WarnUnreferenced(buf))
+	require.Empty(t, buf.String())
+
+	result, err := Match("Suite", "TestA", "user", "snapshot")
+	require.NoError(t, err)
+	require.True(t, result.Matched)
+
+	require.Equal(t, []string{Path("Suite", "TestB", "old")}, Unreferenced())
+	require.False(t, WarnUnreferenced(buf))
+	require.Equal(t, "allure-go: 1 snapshots are not referenced by any test:\n\t"+Path("Suite", "TestB", "old")+"\n", buf.String())
+}
@@ -67,6 +67,13 @@ type Parameters interface {
 	WithNewParameters(kv ...interface{})
 }
 
+type StatusDetails interface {
+	Known()
+	KnownIssue(issue string)
+	Muted()
+	Flaky()
+}
+
 type AllureForward interface {
 	DescriptionLabels
 	SuiteLabels
@@ -75,6 +82,7 @@ type AllureForward interface {
 	AllureSteps
 	Attachments
 	Parameters
+	StatusDetails
 }
 
 type AllureForwardFull interface {
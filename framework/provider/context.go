@@ -0,0 +1,32 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/louisun/allure-go-v2/allure"
+)
+
+// StepParent is the test or the step, which child steps, attachments and parameters can be added to
+type StepParent interface {
+	Step(step *allure.Step)
+	WithNewStep(stepName string, step func(sCtx StepCtx), params ...*allure.Parameter)
+	WithNewAttachment(name string, mimeType allure.MimeType, content []byte)
+	WithNewParameters(kv ...interface{})
+}
+
+type stepParentKey struct{}
+
+// ContextWithStep returns copy of the ctx carrying the current step
+func ContextWithStep(ctx context.Context, step StepParent) context.Context {
+	return context.WithValue(ctx, stepParentKey{}, step)
+}
+
+// StepFromContext returns the current step carried by the ctx.
+// The step is the test itself, if the ctx is the test's context.
+func StepFromContext(ctx context.Context) (StepParent, bool) {
+	if ctx == nil {
+		return nil, false
+	}
+	step, ok := ctx.Value(stepParentKey{}).(StepParent)
+	return step, ok
+}
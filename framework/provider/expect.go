@@ -0,0 +1,36 @@
+package provider
+
+import (
+	"github.com/louisun/allure-go-v2/allure"
+)
+
+// Matcher checks the actual value of the Expectation. Custom matchers are passed to Expectation.To
+type Matcher interface {
+	// Name returns the name of the assert step, e.g. "Equal"
+	Name() string
+	// Parameters returns parameters of the assert step
+	Parameters(actual interface{}) []*allure.Parameter
+	// Match returns false and the failure message if the actual value doesn't match
+	Match(actual interface{}) (ok bool, failureMessage string)
+}
+
+// Expectation is the fluent assertion of the actual value. Each link of the chain is the assert step.
+// Expectation of Assert() lets the test go on after the failed link, Expectation of Require() stops the test.
+//
+//	t.Expect(resp.Code).ToEqual(200)
+//	t.Require().Expect(items).ToHaveLen(3).ToContain(item)
+type Expectation interface {
+	To(matcher Matcher) Expectation
+	ToEqual(expected interface{}) Expectation
+	NotToEqual(expected interface{}) Expectation
+	ToBeNil() Expectation
+	NotToBeNil() Expectation
+	ToBeTrue() Expectation
+	ToBeFalse() Expectation
+	ToBeEmpty() Expectation
+	NotToBeEmpty() Expectation
+	ToHaveLen(length int) Expectation
+	ToContain(element interface{}) Expectation
+	NotToContain(element interface{}) Expectation
+	ToWrap(target error) Expectation
+}
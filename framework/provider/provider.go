@@ -32,10 +32,10 @@ type TestMeta interface {
 
 	GetContainer() *allure.Container
 
-	SetBeforeEach(hook func(T))
-	GetBeforeEach() func(T)
-	SetAfterEach(hook func(T))
-	GetAfterEach() func(T)
+	SetBeforeEach(hooks ...Hook)
+	GetBeforeEach() []Hook
+	SetAfterEach(hooks ...Hook)
+	GetAfterEach() []Hook
 }
 
 type SuiteMeta interface {
@@ -46,10 +46,17 @@ type SuiteMeta interface {
 	GetSuiteFullName() string
 	GetContainer() *allure.Container
 
-	SetBeforeAll(func(T))
-	SetAfterAll(func(T))
-	GetBeforeAll() func(T)
-	GetAfterAll() func(T)
+	SetBeforeAll(hooks ...Hook)
+	SetAfterAll(hooks ...Hook)
+	GetBeforeAll() []Hook
+	GetAfterAll() []Hook
+}
+
+// Hook is a hook of the test or the suite.
+// Named hook is run as a separate sub-test and its steps are grouped into the step with its name.
+type Hook struct {
+	Name string
+	Body func(T)
 }
 
 type ExecutionContext interface {
@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -29,14 +30,25 @@ type T interface {
 	SkipOnPrint()
 	Assert() Asserts
 	Require() Asserts
+	// SoftAssert runs all assertions of the group, even if some of them fail, and reports their failures at once
+	SoftAssert(name string, assertions func(a Asserts))
+	// Expect starts the fluent assertion of the actual value. It's the shortcut of Assert().Expect
+	Expect(actual interface{}) Expectation
 	Run(testName string, testBody func(T), tags ...string) *allure.Result
 
 	LogStep(args ...interface{})
 	LogfStep(format string, args ...interface{})
 	WithNewStep(stepName string, step func(sCtx StepCtx), params ...*allure.Parameter)
 	WithNewAsyncStep(stepName string, step func(sCtx StepCtx), params ...*allure.Parameter)
+	WithNewTimeoutStep(stepName string, timeout time.Duration, step func(sCtx StepCtx), params ...*allure.Parameter)
+	WithEventuallyStep(stepName string, timeout, interval time.Duration, condition func(sCtx StepCtx) bool, params ...*allure.Parameter)
+	WithTimeout(timeout time.Duration)
 	WithTestSetup(setup func(T))
 	WithTestTeardown(teardown func(T))
+
+	// Context returns context carrying the test as the current step. It has the deadline of the test
+	// and is cancelled when the test (or the hook it's called from) ends or times out.
+	Context() context.Context
 }
 
 type StepCtx interface {
@@ -44,6 +56,8 @@ type StepCtx interface {
 	NewStep(stepName string, parameters ...*allure.Parameter)
 	WithNewStep(stepName string, step func(sCtx StepCtx), params ...*allure.Parameter)
 	WithNewAsyncStep(stepName string, step func(sCtx StepCtx), params ...*allure.Parameter)
+	WithNewTimeoutStep(stepName string, timeout time.Duration, step func(sCtx StepCtx), params ...*allure.Parameter)
+	WithEventuallyStep(stepName string, timeout, interval time.Duration, condition func(sCtx StepCtx) bool, params ...*allure.Parameter)
 
 	WithParameters(parameters ...*allure.Parameter)
 	WithNewParameters(kv ...interface{})
@@ -53,6 +67,10 @@ type StepCtx interface {
 
 	Assert() Asserts
 	Require() Asserts
+	// SoftAssert runs all assertions of the group, even if some of them fail, and reports their failures at once
+	SoftAssert(name string, assertions func(a Asserts))
+	// Expect starts the fluent assertion of the actual value. It's the shortcut of Assert().Expect
+	Expect(actual interface{}) Expectation
 
 	LogStep(args ...interface{})
 	LogfStep(format string, args ...interface{})
@@ -70,6 +88,10 @@ type StepCtx interface {
 	Break(args ...interface{})
 	Breakf(format string, args ...interface{})
 	Name() string
+
+	// Context returns context carrying the step as the current step. It's derived from the context of the parent
+	// step or test and is cancelled when the step ends.
+	Context() context.Context
 }
 
 // Asserts ...
@@ -101,6 +123,11 @@ type Asserts interface {
 	WithinDuration(expected, actual time.Time, delta time.Duration, msgAndArgs ...interface{})
 	JSONEq(expected, actual string, msgAndArgs ...interface{})
 	JSONContains(expected, actual string, msgAndArgs ...interface{})
+	JSONPathEqual(actual string, path string, expected interface{}, msgAndArgs ...interface{})
+	JSONPathExists(actual string, path string, msgAndArgs ...interface{})
+	JSONPathLen(actual string, path string, length int, msgAndArgs ...interface{})
+	MatchesJSONSchema(schema string, actual string, msgAndArgs ...interface{})
+	MatchesSnapshot(name string, value interface{}, msgAndArgs ...interface{})
 	Subset(list, subset interface{}, msgAndArgs ...interface{})
 	NotSubset(list, subset interface{}, msgAndArgs ...interface{})
 	IsType(expectedType interface{}, object interface{}, msgAndArgs ...interface{})
@@ -113,4 +140,7 @@ type Asserts interface {
 	Zero(i interface{}, msgAndArgs ...interface{})
 	NotZero(i interface{}, msgAndArgs ...interface{})
 	InDelta(expected, actual interface{}, delta float64, msgAndArgs ...interface{})
+	Eventually(condition func() bool, waitFor time.Duration, tick time.Duration, msgAndArgs ...interface{})
+	Never(condition func() bool, waitFor time.Duration, tick time.Duration, msgAndArgs ...interface{})
+	Expect(actual interface{}) Expectation
 }
@@ -5,6 +5,9 @@ const (
 	tableTestPrefix  = "TableTest"
 	testPrefix       = "Test"
 
+	// testOrderMethod is the name of WithTestOrderSuite's method, which is not a test
+	testOrderMethod = "TestOrder"
+
 	// magic number of depth caller to find test's caller package
 	defaultPackageDepth = 2
 )
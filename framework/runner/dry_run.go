@@ -0,0 +1,70 @@
+package runner
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"sync"
+
+	"github.com/louisun/allure-go-v2/allure"
+)
+
+const dryRunMessage = "Test is not run in dry-run mode"
+
+var (
+	dryRun         = flag.Bool("allure-go.dry-run", false, "collect tests of the allure-go suites and report them as skipped without running hooks and test bodies")
+	dryRunManifest = flag.String("allure-go.dry-run-manifest", "", "file to write the list of collected tests of the package to in dry-run mode instead of skipped results")
+)
+
+// ManifestEntry describes the test collected in dry-run mode
+type ManifestEntry struct {
+	Name       string              `json:"name"`
+	FullName   string              `json:"fullName"`
+	Labels     []*allure.Label     `json:"labels,omitempty"`
+	Links      []*allure.Link      `json:"links,omitempty"`
+	Parameters []*allure.Parameter `json:"parameters,omitempty"`
+}
+
+// manifest collects tests of all suites of the package's test binary run in dry-run mode.
+// Each binary writes its own manifest, so packages must not share the path
+var manifest = struct {
+	mu      sync.Mutex
+	entries []ManifestEntry
+}{}
+
+// runDry reports the tests of the runner without running them.
+// If -allure-go.dry-run-manifest is set, tests are written to the manifest, otherwise as skipped results.
+func (r *runner) runDry(result SuiteResult) error {
+	names := r.orderedTests()
+	if *dryRunManifest == "" {
+		for _, name := range names {
+			skipTest(dryRunMessage, r.tests[name].GetMeta(), result)
+		}
+		finishSuite(r.t().GetProvider())
+		return nil
+	}
+
+	manifest.mu.Lock()
+	defer manifest.mu.Unlock()
+
+	for _, name := range names {
+		res := r.tests[name].GetMeta().GetResult()
+		manifest.entries = append(manifest.entries, ManifestEntry{
+			Name:       res.Name,
+			FullName:   res.FullName,
+			Labels:     res.Labels,
+			Links:      res.Links,
+			Parameters: res.Parameters,
+		})
+	}
+	// manifest is rewritten by every suite of the binary, so it is complete whichever suite runs last
+	data, err := json.MarshalIndent(manifest.entries, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal dry-run manifest: %w", err)
+	}
+	if err = ioutil.WriteFile(*dryRunManifest, data, 0o644); err != nil {
+		return fmt.Errorf("failed to write dry-run manifest: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,396 @@
+package runner
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/louisun/allure-go-v2/allure"
+	"github.com/louisun/allure-go-v2/framework/provider"
+)
+
+const (
+	// fixtureTagOption marks the suite field filled by the fixture: `allure:"fixture,scope=suite,name=db"`
+	fixtureTagOption = "fixture"
+	// fixtureScopeTagPrefix is prefix of the scope option of the fixture tag
+	fixtureScopeTagPrefix = "scope="
+	// fixtureNameTagPrefix is prefix of the name option of the fixture tag. Name of the field is used by default
+	fixtureNameTagPrefix = "name="
+
+	fixtureSetUpStep    = "Set up %s"
+	fixtureTearDownStep = "Tear down %s"
+	// fixturesHookName is the name of hooks setting up and tearing down fixtures
+	fixturesHookName = "fixtures"
+)
+
+// fixtureScope is the lifetime of the fixture value
+type fixtureScope string
+
+// fixtureScope constants
+const (
+	// fixtureScopeTest fixture is set up before each test and torn down after it
+	fixtureScopeTest fixtureScope = "test"
+	// fixtureScopeSuite fixture is set up before all tests of the suite and torn down after them
+	fixtureScopeSuite fixtureScope = "suite"
+	// fixtureScopePackage fixture is set up once for all suites of the package and torn down by TearDownPackageFixtures
+	fixtureScopePackage fixtureScope = "package"
+)
+
+var teardownType = reflect.TypeOf(func() {})
+
+// fixtureProvider is the registered provider of the fixture
+type fixtureProvider struct {
+	name      string
+	setup     reflect.Value
+	dependsOn []string
+}
+
+var (
+	fixturesMu       sync.RWMutex
+	fixtureProviders = make(map[string]*fixtureProvider)
+
+	packageFixtures = newFixtureSet()
+)
+
+// RegisterFixture registers provider of the fixture, which fills suite fields tagged `allure:"fixture"`.
+// setup must be func(provider.T, <dependencies>...) (<fixture type>, func()), where dependencies are values
+// of the fixtures named in dependsOn. Returned func tears the fixture down and can be nil.
+// Registering the fixture with the same name twice panics.
+func RegisterFixture(name string, setup interface{}, dependsOn ...string) {
+	setupFunc := reflect.ValueOf(setup)
+	setupType := setupFunc.Type()
+	if setupFunc.Kind() != reflect.Func ||
+		setupType.NumIn() != len(dependsOn)+1 || setupType.In(0) != testingTType ||
+		setupType.NumOut() != 2 || setupType.Out(1) != teardownType {
+		panic(fmt.Sprintf("setup of fixture %s must be func(provider.T, <%d dependencies>) (<fixture type>, func()), got %T", name, len(dependsOn), setup))
+	}
+
+	fixturesMu.Lock()
+	defer fixturesMu.Unlock()
+	if _, ok := fixtureProviders[name]; ok {
+		panic(fmt.Sprintf("fixture %s is already registered", name))
+	}
+	fixtureProviders[name] = &fixtureProvider{name: name, setup: setupFunc, dependsOn: dependsOn}
+}
+
+// TearDownPackageFixtures tears down fixtures with package scope in reverse order they were set up
+// and prints their container. It should be called from TestMain after m.Run().
+// Nothing is printed if no package fixture was set up.
+func TearDownPackageFixtures() error {
+	if packageFixtures.isEmpty() {
+		return nil
+	}
+	container := packageFixtures.container
+	err := packageFixtures.tearDown(&container.Afters)
+	if printErr := container.Done(); err == nil {
+		err = printErr
+	}
+	return err
+}
+
+func findFixtureProvider(name string) (*fixtureProvider, bool) {
+	fixturesMu.RLock()
+	defer fixturesMu.RUnlock()
+	p, ok := fixtureProviders[name]
+	return p, ok
+}
+
+// checkFixture checks the fixture and its dependencies are registered,
+// dependencies have no cycles and their values can be passed to the setup
+func checkFixture(name string, visiting map[string]bool) (reflect.Type, error) {
+	p, ok := findFixtureProvider(name)
+	if !ok {
+		return nil, fmt.Errorf("fixture %s is not registered", name)
+	}
+	if visiting[name] {
+		return nil, fmt.Errorf("fixture %s depends on itself", name)
+	}
+	visiting[name] = true
+	defer delete(visiting, name)
+
+	for i, dep := range p.dependsOn {
+		depType, err := checkFixture(dep, visiting)
+		if err != nil {
+			return nil, err
+		}
+		if argType := p.setup.Type().In(i + 1); !depType.AssignableTo(argType) {
+			return nil, fmt.Errorf("fixture %s of type %s can't be passed as %s to the setup of fixture %s", dep, depType, argType, name)
+		}
+	}
+	return p.setup.Type().Out(0), nil
+}
+
+// fixtureField is the suite's field filled by the fixture
+type fixtureField struct {
+	name  string
+	scope fixtureScope
+	value reflect.Value
+}
+
+// findFixtureFields returns fields of the suite tagged `allure:"fixture"` grouped by scope
+func findFixtureFields(suite TestSuite) (map[fixtureScope][]fixtureField, error) {
+	ptr := reflect.ValueOf(suite)
+	if ptr.Kind() != reflect.Ptr || ptr.Elem().Kind() != reflect.Struct {
+		return nil, nil
+	}
+	fields := make(map[fixtureScope][]fixtureField)
+	for _, field := range reflect.VisibleFields(ptr.Elem().Type()) {
+		name, scope, ok, err := parseFixtureTag(field)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			continue
+		}
+		valueType, err := checkFixture(name, make(map[string]bool))
+		if err != nil {
+			return nil, fmt.Errorf("field %s: %w", field.Name, err)
+		}
+		if !valueType.AssignableTo(field.Type) {
+			return nil, fmt.Errorf("field %s of type %s can't be filled by fixture %s of type %s", field.Name, field.Type, name, valueType)
+		}
+		fields[scope] = append(fields[scope], fixtureField{
+			name:  name,
+			scope: scope,
+			value: exportedField(ptr.Elem(), field),
+		})
+	}
+	return fields, nil
+}
+
+// parseFixtureTag returns name and scope of the fixture filling the field. Scope is test by default
+func parseFixtureTag(field reflect.StructField) (name string, scope fixtureScope, ok bool, err error) {
+	options := strings.Split(field.Tag.Get(paramTagName), ",")
+	if options[0] != fixtureTagOption {
+		return "", "", false, nil
+	}
+	name, scope = field.Name, fixtureScopeTest
+	for _, option := range options[1:] {
+		switch {
+		case strings.HasPrefix(option, fixtureNameTagPrefix):
+			name = strings.TrimPrefix(option, fixtureNameTagPrefix)
+		case strings.HasPrefix(option, fixtureScopeTagPrefix):
+			scope = fixtureScope(strings.TrimPrefix(option, fixtureScopeTagPrefix))
+			if scope != fixtureScopeTest && scope != fixtureScopeSuite && scope != fixtureScopePackage {
+				return "", "", false, fmt.Errorf("field %s: unknown fixture scope %s", field.Name, scope)
+			}
+		default:
+			return "", "", false, fmt.Errorf("field %s: unknown fixture option %s", field.Name, option)
+		}
+	}
+	return name, scope, true, nil
+}
+
+// fixtureTeardown is the teardown of the fixture set up in the scope
+type fixtureTeardown struct {
+	name     string
+	teardown func()
+}
+
+// fixtureSet keeps fixtures set up in the scope
+type fixtureSet struct {
+	mu        sync.Mutex
+	container *allure.Container
+	values    map[string]reflect.Value
+	// teardowns are kept in order the fixtures were set up, so dependencies go before dependent fixtures
+	teardowns []fixtureTeardown
+}
+
+func newFixtureSet() *fixtureSet {
+	set := &fixtureSet{
+		container: allure.NewContainer(),
+		values:    make(map[string]reflect.Value),
+	}
+	set.container.Begin()
+	return set
+}
+
+// isEmpty returns true if no fixture of the set was set up
+func (s *fixtureSet) isEmpty() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.values) == 0 && len(s.teardowns) == 0
+}
+
+func (s *fixtureSet) lookup(name string) (reflect.Value, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	value, ok := s.values[name]
+	return value, ok
+}
+
+// fill sets up fixtures of the fields, which are not set up in the set or in the wider sets, and fills the fields.
+// Steps added by the setups to the from steps are moved into Set up steps, which are added to the to steps.
+func (s *fixtureSet) fill(t provider.T, fields []fixtureField, wider []*fixtureSet, from, to *[]*allure.Step) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, field := range fields {
+		field.value.Set(s.setUp(t, field.name, wider, from, to))
+	}
+}
+
+func (s *fixtureSet) setUp(t provider.T, name string, wider []*fixtureSet, from, to *[]*allure.Step) reflect.Value {
+	if value, ok := s.values[name]; ok {
+		return value
+	}
+	for _, set := range wider {
+		if value, ok := set.lookup(name); ok {
+			return value
+		}
+	}
+	// fixtures are checked while collecting the suite
+	p, _ := findFixtureProvider(name)
+	args := []reflect.Value{reflect.ValueOf(t)}
+	for _, dep := range p.dependsOn {
+		args = append(args, s.setUp(t, dep, wider, from, to))
+	}
+
+	var out []reflect.Value
+	_ = fixtureStep(from, to, fmt.Sprintf(fixtureSetUpStep, name), func() error {
+		out = p.setup.Call(args)
+		return nil
+	})
+	s.values[name] = out[0]
+	if teardown := out[1].Interface().(func()); teardown != nil {
+		s.teardowns = append(s.teardowns, fixtureTeardown{name: name, teardown: teardown})
+	}
+	return out[0]
+}
+
+// tearDown tears down fixtures of the set in reverse order they were set up.
+// Every teardown is recorded as Tear down step. Panic of the teardown doesn't stop the rest ones
+// and is returned as an error.
+func (s *fixtureSet) tearDown(steps *[]*allure.Step) (err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for i := len(s.teardowns) - 1; i >= 0; i-- {
+		teardown := s.teardowns[i]
+		func() {
+			defer func() {
+				if rec := recover(); rec != nil && err == nil {
+					err = fmt.Errorf("teardown of fixture %s panicked: %v", teardown.name, rec)
+				}
+			}()
+			_ = fixtureStep(steps, steps, fmt.Sprintf(fixtureTearDownStep, teardown.name), func() error {
+				teardown.teardown()
+				return nil
+			})
+		}()
+	}
+	s.teardowns = nil
+	s.values = make(map[string]reflect.Value)
+	return err
+}
+
+// fixtureStep runs the body and adds the step with the name to the to steps.
+// Steps added by the body to the from steps become children of the step.
+// The step is failed if the body returns an error, panics or exits the goroutine.
+func fixtureStep(from, to *[]*allure.Step, name string, body func() error) (err error) {
+	var (
+		first  = len(*from)
+		step   = allure.NewSimpleStep(name)
+		passed bool
+	)
+	defer func() {
+		if first > len(*from) {
+			first = len(*from)
+		}
+		for _, child := range (*from)[first:] {
+			child.WithParent(step)
+		}
+		*from = (*from)[:first]
+		step.Finish()
+		if !passed {
+			step.Failed()
+		}
+		*to = append(*to, step)
+	}()
+	err = body()
+	passed = err == nil
+	return err
+}
+
+// fixturesHolder keeps test fixtures of the suite held by the test
+type fixturesHolder struct {
+	mu     sync.Mutex
+	holder provider.T
+	set    *fixtureSet
+}
+
+// hold returns new set of test fixtures for the test. It fails if another test holds the fixtures
+func (h *fixturesHolder) hold(t provider.T) (*fixtureSet, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.holder != nil {
+		return nil, fmt.Errorf("test fixtures of the suite are held by %s: tests using test fixtures can't run in parallel", h.holder.Name())
+	}
+	h.holder, h.set = t, newFixtureSet()
+	return h.set, nil
+}
+
+// release returns set of test fixtures held by the test, so it can be torn down
+func (h *fixturesHolder) release(t provider.T) (*fixtureSet, bool) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.holder != t {
+		return nil, false
+	}
+	set := h.set
+	h.holder, h.set = nil, nil
+	return set, true
+}
+
+// collectFixtures adds hooks filling the suite's fields tagged `allure:"fixture"`.
+// The hooks are added before the suite's ones, so fixtures are set up before the suite's before hooks
+// and torn down after the suite's after hooks.
+// Test fixtures fill fields of the shared suite struct, so only one test can hold them at a time:
+// test started while another one holds them (e.g. paused by t.Parallel) fails its setup.
+func collectFixtures(runner *suiteRunner, suite TestSuite) *suiteRunner {
+	fields, err := findFixtureFields(suite)
+	if err != nil {
+		panic(fmt.Sprintf("failed to collect fixtures of suite %s: %s", runner.suiteName, err))
+	}
+
+	if len(fields[fixtureScopePackage]) > 0 {
+		runner.parentContainers = append(runner.parentContainers, packageFixtures.container)
+	}
+	suiteFixtures := newFixtureSet()
+	if len(fields[fixtureScopePackage]) > 0 || len(fields[fixtureScopeSuite]) > 0 {
+		runner.WithBeforeAll(fixturesHookName, func(t provider.T) {
+			suiteContainer := t.(internalT).GetProvider().GetSuiteMeta().GetContainer()
+			packageFixtures.fill(t, fields[fixtureScopePackage], nil, &suiteContainer.Befores, &packageFixtures.container.Befores)
+			suiteFixtures.fill(t, fields[fixtureScopeSuite], []*fixtureSet{packageFixtures}, &suiteContainer.Befores, &suiteContainer.Befores)
+		})
+		runner.WithAfterAll(fixturesHookName, func(t provider.T) {
+			suiteContainer := t.(internalT).GetProvider().GetSuiteMeta().GetContainer()
+			if err := suiteFixtures.tearDown(&suiteContainer.Afters); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+
+	if len(fields[fixtureScopeTest]) > 0 {
+		testFixtures := &fixturesHolder{}
+		runner.WithBeforeEach(fixturesHookName, func(t provider.T) {
+			set, err := testFixtures.hold(t)
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			testContainer := t.(internalT).GetProvider().GetTestMeta().GetContainer()
+			set.fill(t, fields[fixtureScopeTest], []*fixtureSet{suiteFixtures, packageFixtures}, &testContainer.Befores, &testContainer.Befores)
+		})
+		runner.WithAfterEach(fixturesHookName, func(t provider.T) {
+			set, ok := testFixtures.release(t)
+			if !ok {
+				return
+			}
+			testContainer := t.(internalT).GetProvider().GetTestMeta().GetContainer()
+			if err := set.tearDown(&testContainer.Afters); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+	return runner
+}
@@ -0,0 +1,97 @@
+package runner
+
+import (
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/louisun/allure-go-v2/framework/core/common"
+	"github.com/louisun/allure-go-v2/framework/provider"
+	"github.com/stretchr/testify/require"
+)
+
+type fixtureTagSuite struct {
+	Default  int `allure:"fixture"`
+	Named    int `allure:"fixture,name=number,scope=package"`
+	Unknown  int `allure:"fixture,scope=global"`
+	NotFixed int `allure:"value"`
+}
+
+func TestParseFixtureTag(t *testing.T) {
+	suiteType := reflect.TypeOf(fixtureTagSuite{})
+
+	name, scope, ok, err := parseFixtureTag(suiteType.Field(0))
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, "Default", name)
+	require.Equal(t, fixtureScopeTest, scope)
+
+	name, scope, ok, err = parseFixtureTag(suiteType.Field(1))
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, "number", name)
+	require.Equal(t, fixtureScopePackage, scope)
+
+	_, _, _, err = parseFixtureTag(suiteType.Field(2))
+	require.Error(t, err)
+
+	_, _, ok, err = parseFixtureTag(suiteType.Field(3))
+	require.NoError(t, err)
+	require.False(t, ok)
+}
+
+func TestTearDownPackageFixtures_empty(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv("ALLURE_OUTPUT_PATH", dir)
+
+	require.NoError(t, TearDownPackageFixtures())
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Empty(t, entries)
+}
+
+func TestFixturesHolder(t *testing.T) {
+	holder := &fixturesHolder{}
+	first, second := common.NewT(t), common.NewT(t)
+
+	set, err := holder.hold(first)
+	require.NoError(t, err)
+	require.NotNil(t, set)
+
+	_, err = holder.hold(second)
+	require.Error(t, err)
+	_, ok := holder.release(second)
+	require.False(t, ok)
+
+	released, ok := holder.release(first)
+	require.True(t, ok)
+	require.Same(t, set, released)
+
+	_, err = holder.hold(second)
+	require.NoError(t, err)
+}
+
+func TestCheckFixture(t *testing.T) {
+	RegisterFixture("checkNumber", func(t provider.T) (int, func()) { return 1, nil })
+	RegisterFixture("checkSum", func(t provider.T, n int) (int, func()) { return n + 1, nil }, "checkNumber")
+	RegisterFixture("checkString", func(t provider.T, n string) (string, func()) { return n, nil }, "checkNumber")
+	RegisterFixture("checkCycle", func(t provider.T, n int) (int, func()) { return n, nil }, "checkCycle")
+
+	valueType, err := checkFixture("checkSum", make(map[string]bool))
+	require.NoError(t, err)
+	require.Equal(t, reflect.TypeOf(0), valueType)
+
+	_, err = checkFixture("checkString", make(map[string]bool))
+	require.Error(t, err)
+	_, err = checkFixture("checkCycle", make(map[string]bool))
+	require.Error(t, err)
+	_, err = checkFixture("checkMissing", make(map[string]bool))
+	require.Error(t, err)
+
+	require.Panics(t, func() {
+		RegisterFixture("checkNumber", func(t provider.T) (int, func()) { return 1, nil })
+	})
+	require.Panics(t, func() {
+		RegisterFixture("checkInvalid", func(t provider.T) int { return 1 })
+	})
+}
@@ -3,6 +3,7 @@ package runner
 import (
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/louisun/allure-go-v2/allure"
 	"github.com/louisun/allure-go-v2/framework/provider"
@@ -38,6 +39,42 @@ type WithTestPramsSuite interface {
 	InitTestParams()
 }
 
+// NamedParam can be implemented by the param of the table test
+// to set the name of the test case.
+type NamedParam interface {
+	Name() string
+}
+
+// AllureIDParam can be implemented by the param of the table test
+// to set ALLURE_ID of the test case. ALLURE_ID mapping of the suite or the runner has priority over it.
+type AllureIDParam interface {
+	AllureID() string
+}
+
+// WithRetriesSuite has a Retries method, which returns how many times
+// failed tests of the suite will be retried. Overrides -allure-go.retries flag.
+type WithRetriesSuite interface {
+	Retries() int
+}
+
+// WithTestRetriesSuite has a FindRetries method, which returns how many times
+// failed test of the suite will be retried. Overrides suite's retries.
+type WithTestRetriesSuite interface {
+	FindRetries(testName string) (retries int, ok bool)
+}
+
+// WithTimeoutSuite has a Timeout method, which returns default timeout
+// of the suite's test bodies. Overrides -allure-go.timeout flag.
+type WithTimeoutSuite interface {
+	Timeout() time.Duration
+}
+
+// WithTestOrderSuite has a TestOrder method, which returns names of the suite's test methods
+// in order they have to be run with declared order. Tests not listed run after the listed ones alphabetically.
+type WithTestOrderSuite interface {
+	TestOrder() []string
+}
+
 type TestSuite interface {
 	GetRunner() TestRunner
 	SetRunner(runner TestRunner)
@@ -53,10 +90,16 @@ type TestingT interface {
 
 type TestRunner interface {
 	NewTest(testName string, testBody func(provider.T), tags ...string)
+	NewParametrizedTest(testName string, cases interface{}, testBody interface{}, tags ...string)
+	AddAllureIDMapping(testName, allureID string)
 	BeforeEach(hookBody func(provider.T))
 	AfterEach(hookBody func(provider.T))
 	BeforeAll(hookBody func(provider.T))
 	AfterAll(hookBody func(provider.T))
+	WithBeforeEach(name string, hookBody func(provider.T))
+	WithAfterEach(name string, hookBody func(provider.T))
+	WithBeforeAll(name string, hookBody func(provider.T))
+	WithAfterAll(name string, hookBody func(provider.T))
 	RunTests() SuiteResult
 }
 
@@ -70,6 +113,7 @@ type SuiteResult interface {
 	GetContainer() *allure.Container
 	GetAllTestResults() []TestResult
 	GetResultByName(name string) TestResult
+	GetAttemptsByName(name string) []TestResult
 	GetResultByUUID(uuid string) TestResult
 	ToJSON() ([]byte, error)
 }
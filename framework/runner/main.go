@@ -0,0 +1,213 @@
+package runner
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"sync"
+	"testing"
+
+	"github.com/louisun/allure-go-v2/allure"
+	"github.com/louisun/allure-go-v2/framework/core/allure_manager/rerun"
+	"github.com/louisun/allure-go-v2/framework/core/allure_manager/selector"
+	"github.com/louisun/allure-go-v2/framework/core/allure_manager/testplan"
+	"github.com/louisun/allure-go-v2/framework/core/snapshot"
+)
+
+// MainOption configures the launch run by Main
+type MainOption func(l *launch)
+
+// launchHook is the hook of the launch. Hooks of the launch run without provider.T,
+// so the hook reports its failure by returning an error
+type launchHook struct {
+	name string
+	body func() error
+}
+
+// launch describes the run of the package's test binary
+type launch struct {
+	container   *allure.Container
+	beforeAll   []launchHook
+	afterAll    []launchHook
+	environment map[string]string
+	executor    *allure.Executor
+	categories  []allure.Category
+}
+
+var (
+	launchMu      sync.RWMutex
+	currentLaunch *launch
+)
+
+// WithLaunchBeforeAll adds hook, which runs once before all tests of the package.
+// Hooks run in order they were added and stop at the first failed one. If any hook fails, tests are not run.
+func WithLaunchBeforeAll(name string, hook func() error) MainOption {
+	return func(l *launch) {
+		l.beforeAll = append(l.beforeAll, launchHook{name: name, body: hook})
+	}
+}
+
+// WithLaunchAfterAll adds hook, which runs once after all tests of the package. Hooks run in reverse order they were added
+func WithLaunchAfterAll(name string, hook func() error) MainOption {
+	return func(l *launch) {
+		l.afterAll = append(l.afterAll, launchHook{name: name, body: hook})
+	}
+}
+
+// WithEnvironment sets properties shown in the "Environment" widget of the report
+func WithEnvironment(environment map[string]string) MainOption {
+	return func(l *launch) {
+		if l.environment == nil {
+			l.environment = make(map[string]string)
+		}
+		for key, value := range environment {
+			l.environment[key] = value
+		}
+	}
+}
+
+// WithExecutor sets the CI build the launch is run by
+func WithExecutor(executor allure.Executor) MainOption {
+	return func(l *launch) {
+		l.executor = &executor
+	}
+}
+
+// WithCategories adds categories of failed and broken results shown in the "Categories" tab of the report
+func WithCategories(categories ...allure.Category) MainOption {
+	return func(l *launch) {
+		l.categories = append(l.categories, categories...)
+	}
+}
+
+// testingM is implemented by *testing.M
+type testingM interface {
+	Run() int
+}
+
+// Main runs tests of the package and exits with their exit code. It should be called from TestMain:
+//
+//	func TestMain(m *testing.M) {
+//		runner.Main(m, runner.WithEnvironment(map[string]string{"stand": "stage"}))
+//	}
+//
+// Main writes environment.properties, executor.json and categories.json, runs launch hooks recorded
+// in the launch container linked to every test of the package, tears down package fixtures
+// and warns about testplan entries that matched no test and snapshots that no test referenced.
+func Main(m *testing.M, opts ...MainOption) {
+	os.Exit(runMain(m, opts...))
+}
+
+func runMain(m testingM, opts ...MainOption) (code int) {
+	l := &launch{container: allure.NewContainer()}
+	for _, opt := range opts {
+		opt(l)
+	}
+	if !flag.Parsed() {
+		flag.Parse()
+	}
+
+	l.container.Begin()
+	setLaunch(l)
+	defer setLaunch(nil)
+
+	if err := l.printArtifacts(); err != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "allure-go: %s\n", err)
+	}
+
+	// there are no hooks in dry-run
+	if !*dryRun {
+		for _, hook := range l.beforeAll {
+			if err := runLaunchHook(hook, &l.container.Befores); err != nil {
+				_, _ = fmt.Fprintf(os.Stderr, "allure-go: launch BeforeAll %s failed: %s\n", hook.name, err)
+				code = 1
+				break
+			}
+		}
+	}
+	if code == 0 {
+		code = m.Run()
+	}
+	if !*dryRun {
+		for i := len(l.afterAll) - 1; i >= 0; i-- {
+			if err := runLaunchHook(l.afterAll[i], &l.container.Afters); err != nil {
+				_, _ = fmt.Fprintf(os.Stderr, "allure-go: launch AfterAll %s failed: %s\n", l.afterAll[i].name, err)
+				code = 1
+			}
+		}
+	}
+
+	if err := TearDownPackageFixtures(); err != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "allure-go: %s\n", err)
+		code = 1
+	}
+	if err := l.container.Done(); err != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "allure-go: %s\n", err)
+	}
+	if plan := testplan.GetTestPlan(); plan != nil {
+		plan.WarnUnmatched(os.Stderr)
+	}
+	if snapshotsReliable(code) {
+		snapshot.WarnUnreferenced(os.Stderr)
+	}
+	return code
+}
+
+// snapshotsReliable returns true if all tests of the package were run and passed.
+// Otherwise, snapshots of tests that were filtered out or stopped before checking them can't be told from unreferenced ones
+func snapshotsReliable(code int) bool {
+	if code != 0 {
+		return false
+	}
+	for _, name := range []string{"test.run", "test.skip"} {
+		if f := flag.Lookup(name); f != nil && f.Value.String() != "" {
+			return false
+		}
+	}
+	return *matchMethod == "" && selector.GetExpression() == nil && *shardFlag == "" &&
+		testplan.GetTestPlan() == nil && rerun.GetFailedTests() == nil
+}
+
+func setLaunch(l *launch) {
+	launchMu.Lock()
+	defer launchMu.Unlock()
+	currentLaunch = l
+}
+
+// launchContainers returns container of the launch run by Main, if there is one
+func launchContainers() []*allure.Container {
+	launchMu.RLock()
+	defer launchMu.RUnlock()
+	if currentLaunch == nil {
+		return nil
+	}
+	return []*allure.Container{currentLaunch.container}
+}
+
+// printArtifacts writes launch-wide files of the report
+func (l *launch) printArtifacts() error {
+	if len(l.environment) > 0 {
+		if err := allure.PrintEnvironment(l.environment); err != nil {
+			return err
+		}
+	}
+	if l.executor != nil {
+		if err := l.executor.Print(); err != nil {
+			return err
+		}
+	}
+	if len(l.categories) > 0 {
+		return allure.PrintCategories(l.categories)
+	}
+	return nil
+}
+
+// runLaunchHook runs the hook as the step with its name. Panic of the hook is returned as an error
+func runLaunchHook(hook launchHook, steps *[]*allure.Step) (err error) {
+	defer func() {
+		if rec := recover(); rec != nil {
+			err = fmt.Errorf("panicked: %v", rec)
+		}
+	}()
+	return fixtureStep(steps, steps, hook.name, hook.body)
+}
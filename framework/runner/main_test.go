@@ -0,0 +1,97 @@
+package runner
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/louisun/allure-go-v2/allure"
+	"github.com/louisun/allure-go-v2/framework/provider"
+	"github.com/stretchr/testify/require"
+)
+
+type testingMFunc func() int
+
+func (f testingMFunc) Run() int {
+	return f()
+}
+
+func TestRunMain(t *testing.T) {
+	allureDir := "./allure-results"
+	defer os.RemoveAll(allureDir)
+
+	var (
+		calls  []string
+		result *allure.Result
+		l      *launch
+	)
+	m := testingMFunc(func() int {
+		calls = append(calls, "tests")
+		launchMu.RLock()
+		l = currentLaunch
+		launchMu.RUnlock()
+		result = Run(t, "launchTest", func(t provider.T) {})
+		return 0
+	})
+	hook := func(name string) func() error {
+		return func() error {
+			calls = append(calls, name)
+			return nil
+		}
+	}
+
+	code := runMain(m,
+		WithLaunchBeforeAll("first before", hook("first before")),
+		WithLaunchBeforeAll("second before", hook("second before")),
+		WithLaunchAfterAll("first after", hook("first after")),
+		WithLaunchAfterAll("second after", hook("second after")),
+		WithEnvironment(map[string]string{"stand": "stage"}),
+		WithExecutor(allure.Executor{Name: "CI"}),
+		WithCategories(allure.Category{Name: "Timeouts", MessageRegex: ".*timed out.*"}),
+	)
+	require.Equal(t, 0, code)
+	require.Equal(t, []string{"first before", "second before", "tests", "second after", "first after"}, calls)
+	require.Nil(t, launchContainers())
+
+	require.NotNil(t, l)
+	require.Len(t, l.container.Befores, 2)
+	require.Equal(t, "first before", l.container.Befores[0].Name)
+	require.Len(t, l.container.Afters, 2)
+	require.Contains(t, l.container.Children, result.UUID)
+
+	for _, file := range []string{"environment.properties", "executor.json", "categories.json", l.container.UUID.String() + "-container.json"} {
+		require.FileExists(t, filepath.Join(allureDir, file))
+	}
+}
+
+func TestSnapshotsReliable(t *testing.T) {
+	require.False(t, snapshotsReliable(1))
+
+	setFlag(t, "allure-go.m", "TestUser")
+	require.False(t, snapshotsReliable(0))
+}
+
+func TestRunMain_failedBeforeAll(t *testing.T) {
+	allureDir := "./allure-results"
+	defer os.RemoveAll(allureDir)
+
+	var (
+		run   bool
+		after bool
+	)
+	m := testingMFunc(func() int {
+		run = true
+		return 0
+	})
+	code := runMain(m,
+		WithLaunchBeforeAll("failed", func() error { return errors.New("no database") }),
+		WithLaunchAfterAll("after", func() error {
+			after = true
+			return nil
+		}),
+	)
+	require.Equal(t, 1, code)
+	require.False(t, run)
+	require.True(t, after)
+}
@@ -0,0 +1,76 @@
+package runner
+
+import (
+	"github.com/google/uuid"
+	"github.com/louisun/allure-go-v2/allure"
+	"github.com/louisun/allure-go-v2/framework/provider"
+)
+
+// baseRunner returns runner underlying the TestRunner
+func baseRunner(testRunner TestRunner) (*runner, bool) {
+	switch r := testRunner.(type) {
+	case *runner:
+		return r, r != nil
+	case *suiteRunner:
+		return r.runner, r != nil && r.runner != nil
+	}
+	return nil, false
+}
+
+// inheritHooks wraps tests of the runner with BeforeEach/AfterEach hooks of the parent
+// and links the tests to containers of the parent suite and its ancestors.
+// Unnamed hooks of the parent are named after the parent suite.
+func (r *runner) inheritHooks(parent *runner) {
+	var (
+		parentProvider = parent.internalT.GetProvider()
+		parentName     = parentProvider.GetSuiteMeta().GetSuiteName()
+		parentMeta     = parentProvider.GetTestMeta()
+		testMeta       = r.internalT.GetProvider().GetTestMeta()
+	)
+	// before hooks run in order, after hooks run in reverse order, so the parent's ones go first in both lists
+	testMeta.SetBeforeEach(append(namedHooks(parentName, parentMeta.GetBeforeEach()), testMeta.GetBeforeEach()...)...)
+	testMeta.SetAfterEach(append(namedHooks(parentName, parentMeta.GetAfterEach()), testMeta.GetAfterEach()...)...)
+
+	r.parentContainers = appendContainers(r.parentContainers, parentProvider.GetSuiteMeta().GetContainer())
+	r.parentContainers = appendContainers(r.parentContainers, parent.parentContainers...)
+}
+
+// appendContainers appends containers, which are not in the list yet
+func appendContainers(list []*allure.Container, containers ...*allure.Container) []*allure.Container {
+	for _, container := range containers {
+		found := false
+		for _, listed := range list {
+			if listed == container {
+				found = true
+				break
+			}
+		}
+		if !found {
+			list = append(list, container)
+		}
+	}
+	return list
+}
+
+func namedHooks(name string, hooks []provider.Hook) []provider.Hook {
+	named := make([]provider.Hook, 0, len(hooks))
+	for _, hook := range hooks {
+		if hook.Name == "" {
+			hook.Name = name
+		}
+		named = append(named, hook)
+	}
+	return named
+}
+
+// linkToParents adds the test result to containers of the parent suites
+func linkToParents(containers []*allure.Container, uuid uuid.UUID) {
+	if len(containers) == 0 {
+		return
+	}
+	suiteMu.Lock()
+	defer suiteMu.Unlock()
+	for _, container := range containers {
+		container.AddChild(uuid)
+	}
+}
@@ -0,0 +1,147 @@
+package runner
+
+import (
+	"flag"
+	"fmt"
+	"math/rand"
+	"os"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/louisun/allure-go-v2/allure"
+)
+
+const (
+	declaredOrder     = "declared"
+	alphabeticalOrder = "alphabetical"
+
+	shuffleOff = "off"
+	shuffleOn  = "on"
+)
+
+var (
+	testsOrder  = flag.String("allure-go.order", declaredOrder, "order of the allure-go suite tests: declared or alphabetical")
+	shuffleFlag = flag.String("allure-go.shuffle", shuffleOff, "randomize order of the allure-go suite tests: off, on or seed for the random source")
+)
+
+// addTest adds test to the runner and remembers the order the test was declared in
+func (r *runner) addTest(name string, test Test) {
+	if _, ok := r.tests[name]; !ok {
+		r.order = append(r.order, name)
+	}
+	r.tests[name] = test
+}
+
+// addTestsAt adds tests to the runner in place of the placeholder name in the declared order.
+// If there is no placeholder, tests are added to the end.
+func (r *runner) addTestsAt(placeholder string, names []string, tests map[string]Test) {
+	var added []string
+	for _, name := range names {
+		if _, ok := r.tests[name]; !ok {
+			added = append(added, name)
+		}
+		r.tests[name] = tests[name]
+	}
+	for i, name := range r.order {
+		if name == placeholder {
+			r.order = append(r.order[:i], append(added, r.order[i+1:]...)...)
+			return
+		}
+	}
+	r.order = append(r.order, added...)
+}
+
+// orderedTests returns names of the runner's tests in order they have to be run.
+// Order is chosen by -allure-go.order and -allure-go.shuffle flags.
+// If tests are shuffled, the seed is added as label to every test result, so the order can be replayed.
+func (r *runner) orderedTests() []string {
+	names := make([]string, 0, len(r.tests))
+	declared := make(map[string]bool, len(r.order))
+	for _, name := range r.order {
+		if _, ok := r.tests[name]; ok && !declared[name] {
+			names = append(names, name)
+			declared[name] = true
+		}
+	}
+	// tests added to the runner without addTest are placed after the declared ones alphabetically
+	var rest []string
+	for name := range r.tests {
+		if !declared[name] {
+			rest = append(rest, name)
+		}
+	}
+	sort.Strings(rest)
+	names = append(names, rest...)
+
+	switch *testsOrder {
+	case alphabeticalOrder:
+		sort.Strings(names)
+	case declaredOrder:
+		r.sortByDeclaredOrder(names)
+	default:
+		_, _ = fmt.Fprintf(os.Stderr, "allure-go: invalid value for -allure-go.order: %s\n", *testsOrder)
+		os.Exit(1)
+	}
+
+	seed, ok, err := shuffleSeed(*shuffleFlag)
+	if err != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "allure-go: invalid value for -allure-go.shuffle: %s\n", err)
+		os.Exit(1)
+	}
+	if !ok {
+		return names
+	}
+
+	r.realT().Logf("allure-go: tests of the suite are shuffled with seed %d", seed)
+	rand.New(rand.NewSource(seed)).Shuffle(len(names), func(i, j int) {
+		names[i], names[j] = names[j], names[i]
+	})
+	for _, name := range names {
+		r.tests[name].GetMeta().GetResult().ReplaceLabel(allure.ShuffleSeedLabel(seed))
+	}
+	return names
+}
+
+// sortByDeclaredOrder moves tests listed in TestOrder of the suite to the beginning in the listed order.
+// Cases of the table tests are placed by name of their method.
+// Other tests keep the order they were added in: order of NewTest calls for runners and
+// alphabetical order of methods for suites, since reflection lists methods sorted by name.
+func (r *runner) sortByDeclaredOrder(names []string) {
+	if len(r.declaredOrder) == 0 {
+		return
+	}
+	rank := make(map[string]int, len(r.declaredOrder))
+	for i, name := range r.declaredOrder {
+		if _, ok := rank[name]; !ok {
+			rank[name] = i
+		}
+	}
+	rankOf := func(name string) int {
+		if paramTest, ok := r.tests[name].(parametrizedTest); ok {
+			name = paramTest.GetRawBody().Name
+		}
+		if i, ok := rank[name]; ok {
+			return i
+		}
+		return len(rank)
+	}
+	sort.SliceStable(names, func(i, j int) bool {
+		return rankOf(names[i]) < rankOf(names[j])
+	})
+}
+
+// shuffleSeed parses -allure-go.shuffle value. Returns false if tests should not be shuffled
+func shuffleSeed(value string) (int64, bool, error) {
+	switch value {
+	case "", shuffleOff:
+		return 0, false, nil
+	case shuffleOn:
+		return time.Now().UnixNano(), true, nil
+	}
+	seed, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return 0, false, fmt.Errorf("%q is neither off, on nor an integer seed", value)
+	}
+	return seed, true, nil
+}
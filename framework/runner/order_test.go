@@ -0,0 +1,90 @@
+package runner
+
+import (
+	"flag"
+	"reflect"
+	"testing"
+
+	"github.com/louisun/allure-go-v2/allure"
+	"github.com/louisun/allure-go-v2/framework/core/common"
+	"github.com/louisun/allure-go-v2/framework/provider"
+	"github.com/stretchr/testify/require"
+)
+
+func setFlag(t *testing.T, name, value string) {
+	old := flag.Lookup(name).Value.String()
+	require.NoError(t, flag.Set(name, value))
+	t.Cleanup(func() { _ = flag.Set(name, old) })
+}
+
+func newOrderRunner(t *testing.T, names ...string) *runner {
+	r := &runner{tests: make(map[string]Test), internalT: &common.Common{TestingT: t}}
+	for _, name := range names {
+		r.addTest(name, &testFunc{testMeta: &testMetaMockRunner{result: allure.NewResult(name, name)}, testBody: func(t provider.T) {}})
+	}
+	return r
+}
+
+func TestRunner_orderedTests_declared(t *testing.T) {
+	r := newOrderRunner(t, "TestC", "TestA", "TestB")
+	require.Equal(t, []string{"TestC", "TestA", "TestB"}, r.orderedTests())
+}
+
+func TestRunner_orderedTests_declaredBySuite(t *testing.T) {
+	r := newOrderRunner(t, "TestA", "TestB", "TestC", "TestD")
+	r.addTest("Table_1", &testMethod{testMeta: &testMetaMockRunner{result: allure.NewResult("Table_1", "Table_1")}, testBody: reflect.Method{Name: "TableTestTable"}})
+	r.addTest("Table_2", &testMethod{testMeta: &testMetaMockRunner{result: allure.NewResult("Table_2", "Table_2")}, testBody: reflect.Method{Name: "TableTestTable"}})
+	r.declaredOrder = []string{"TestC", "TableTestTable", "TestA", "TestUnknown"}
+
+	require.Equal(t, []string{"TestC", "Table_1", "Table_2", "TestA", "TestB", "TestD"}, r.orderedTests())
+}
+
+func TestRunner_orderedTests_alphabetical(t *testing.T) {
+	setFlag(t, "allure-go.order", alphabeticalOrder)
+
+	r := newOrderRunner(t, "TestC", "TestA", "TestB")
+	r.declaredOrder = []string{"TestB"}
+	require.Equal(t, []string{"TestA", "TestB", "TestC"}, r.orderedTests())
+}
+
+func TestRunner_orderedTests_notDeclared(t *testing.T) {
+	r := newOrderRunner(t, "TestB")
+	r.tests["TestA"] = &testFunc{testMeta: &testMetaMockRunner{result: allure.NewResult("TestA", "TestA")}}
+	require.Equal(t, []string{"TestB", "TestA"}, r.orderedTests())
+}
+
+func TestRunner_orderedTests_shuffle(t *testing.T) {
+	setFlag(t, "allure-go.shuffle", "42")
+
+	names := []string{"Test1", "Test2", "Test3", "Test4", "Test5", "Test6", "Test7", "Test8"}
+	first := newOrderRunner(t, names...).orderedTests()
+	r := newOrderRunner(t, names...)
+	second := r.orderedTests()
+
+	require.Equal(t, first, second)
+	require.ElementsMatch(t, names, second)
+	require.NotEqual(t, names, second)
+	for _, name := range names {
+		label, ok := r.tests[name].GetMeta().GetResult().GetFirstLabel(allure.ShuffleSeed)
+		require.True(t, ok)
+		require.Equal(t, "42", label.GetValue())
+	}
+}
+
+func TestShuffleSeed(t *testing.T) {
+	_, ok, err := shuffleSeed(shuffleOff)
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	_, ok, err = shuffleSeed(shuffleOn)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	seed, ok, err := shuffleSeed("-7")
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, int64(-7), seed)
+
+	_, _, err = shuffleSeed("sometimes")
+	require.Error(t, err)
+}
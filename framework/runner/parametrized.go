@@ -0,0 +1,60 @@
+package runner
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/louisun/allure-go-v2/allure"
+	"github.com/louisun/allure-go-v2/framework/core/allure_manager/adapter"
+	"github.com/louisun/allure-go-v2/framework/provider"
+)
+
+// NewParametrizedTest adds a test for every element of cases slice.
+// testBody must be func(provider.T, <case type>), where element of cases can be assigned to the case type.
+// Cases are named and get allure parameters the same way as cases of the suite's table tests (see caseName, caseParameters).
+// ALLURE_ID of the case is taken from AddAllureIDMapping by the case name or from the case implementing AllureIDParam.
+// Name of the parametrized test must be unique within the runner, so cases of different calls don't replace each other.
+func (r *runner) NewParametrizedTest(testName string, cases interface{}, testBody interface{}, tags ...string) {
+	body := reflect.ValueOf(testBody)
+	if body.Kind() != reflect.Func || body.Type().NumIn() != 2 || body.Type().In(0) != testingTType || body.Type().NumOut() != 0 {
+		panic(fmt.Sprintf("body of parametrized test %s must be func(provider.T, <case type>), got %T", testName, testBody))
+	}
+	if r.parametrized[testName] {
+		panic(fmt.Sprintf("parametrized test %s is already added", testName))
+	}
+	tableCases, err := productCases(testName, []string{testName}, []reflect.Value{reflect.ValueOf(cases)}, []reflect.Type{body.Type().In(1)})
+	if err != nil {
+		panic(err)
+	}
+	if r.parametrized == nil {
+		r.parametrized = make(map[string]bool)
+	}
+	r.parametrized[testName] = true
+
+	packageName := getPackage(defaultPackageDepth)
+	for _, c := range uniqueCaseNames(tableCases) {
+		testMeta := adapter.NewTestMeta(
+			r.t().GetProvider().GetSuiteMeta().GetSuiteFullName(),
+			r.t().GetProvider().GetSuiteMeta().GetSuiteName(),
+			c.name,
+			packageName,
+			tags...,
+		)
+		testMeta.GetResult().Parameters = append(testMeta.GetResult().Parameters, c.params...)
+		allureID := c.allureID
+		if id, ok := r.allureIDs[c.name]; ok {
+			allureID = id
+		}
+		if allureID != "" {
+			testMeta.GetResult().AddLabel(allure.IDAllureLabel(allureID))
+		}
+		if !r.toRun(testMeta.GetResult()) {
+			continue
+		}
+
+		arg := c.args[0]
+		r.addTest(fmt.Sprintf("%s/%s", r.t().Name(), c.name), newTestFunc(func(t provider.T) {
+			body.Call([]reflect.Value{reflect.ValueOf(t), arg})
+		}, testMeta))
+	}
+}
@@ -0,0 +1,100 @@
+package runner
+
+import (
+	"os"
+	"sync"
+	"testing"
+
+	"github.com/louisun/allure-go-v2/allure"
+	"github.com/louisun/allure-go-v2/framework/provider"
+	"github.com/stretchr/testify/require"
+)
+
+type idParam struct {
+	City string
+	ID   string `allure:"-"`
+}
+
+func (p idParam) AllureID() string {
+	return p.ID
+}
+
+func TestRunner_NewParametrizedTest(t *testing.T) {
+	allureDir := "./allure-results"
+	defer os.RemoveAll(allureDir)
+
+	var (
+		mu  sync.Mutex
+		run []string
+	)
+	r := NewRunner(t, "suiteName")
+	r.NewParametrizedTest("Cities", []idParam{{City: "Moscow", ID: "11"}, {City: "Kazan"}, {City: "Moscow"}}, func(t provider.T, city idParam) {
+		mu.Lock()
+		defer mu.Unlock()
+		run = append(run, city.City)
+	}, "tag")
+	result := r.RunTests()
+
+	require.ElementsMatch(t, []string{"Moscow", "Kazan", "Moscow"}, run)
+	require.Len(t, result.GetAllTestResults(), 3)
+
+	moscow := result.GetResultByName("Cities_{City:Moscow ID:11}").GetResult()
+	require.Equal(t, []*allure.Parameter{allure.NewParameter("City", "Moscow")}, moscow.Parameters)
+	id, ok := moscow.GetFirstLabel(allure.AllureID)
+	require.True(t, ok)
+	require.Equal(t, "11", id.GetValue())
+	tag, ok := moscow.GetFirstLabel(allure.Tag)
+	require.True(t, ok)
+	require.Equal(t, "tag", tag.GetValue())
+
+	kazan := result.GetResultByName("Cities_{City:Kazan ID:}").GetResult()
+	_, ok = kazan.GetFirstLabel(allure.AllureID)
+	require.False(t, ok)
+	require.NotNil(t, result.GetResultByName("Cities_{City:Moscow ID:}"))
+}
+
+func TestRunner_NewParametrizedTest_allureIDMapping(t *testing.T) {
+	allureDir := "./allure-results"
+	defer os.RemoveAll(allureDir)
+
+	r := NewRunner(t, "suiteName")
+	r.AddAllureIDMapping("Cities_Kazan", "12")
+	r.AddAllureIDMapping("Capitals_{City:Moscow ID:11}", "21")
+	r.AddAllureIDMapping("Plain", "31")
+	r.NewParametrizedTest("Cities", []string{"Kazan", "Perm"}, func(t provider.T, city string) {})
+	r.NewParametrizedTest("Capitals", []idParam{{City: "Moscow", ID: "11"}}, func(t provider.T, city idParam) {})
+	r.NewTest("Plain", func(t provider.T) {})
+	result := r.RunTests()
+	require.Len(t, result.GetAllTestResults(), 4)
+
+	for name, expected := range map[string]string{
+		"Cities_Kazan":                 "12",
+		"Capitals_{City:Moscow ID:11}": "21",
+		"Plain":                        "31",
+	} {
+		id, ok := result.GetResultByName(name).GetResult().GetFirstLabel(allure.AllureID)
+		require.True(t, ok, name)
+		require.Equal(t, expected, id.GetValue(), name)
+	}
+	_, ok := result.GetResultByName("Cities_Perm").GetResult().GetFirstLabel(allure.AllureID)
+	require.False(t, ok)
+}
+
+func TestRunner_NewParametrizedTest_invalid(t *testing.T) {
+	r := NewRunner(t, "suiteName")
+	require.Panics(t, func() {
+		r.NewParametrizedTest("Cities", []string{"Moscow"}, func(t provider.T) {})
+	})
+	require.Panics(t, func() {
+		r.NewParametrizedTest("Cities", []string{"Moscow"}, func(t provider.T, n int) {})
+	})
+	require.Panics(t, func() {
+		r.NewParametrizedTest("Cities", "Moscow", func(t provider.T, city string) {})
+	})
+
+	// cases of the second test with the same name would replace the cases of the first one
+	r.NewParametrizedTest("Cities", []string{"Moscow"}, func(t provider.T, city string) {})
+	require.PanicsWithValue(t, "parametrized test Cities is already added", func() {
+		r.NewParametrizedTest("Cities", []string{"Kazan"}, func(t provider.T, city string) {})
+	})
+}
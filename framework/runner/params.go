@@ -0,0 +1,125 @@
+package runner
+
+import (
+	"fmt"
+	"reflect"
+	"unicode/utf8"
+
+	"github.com/louisun/allure-go-v2/allure"
+)
+
+const (
+	// maxCaseNameLen is workaround for t.TempDir()
+	maxCaseNameLen = 150
+
+	paramTagName  = "allure"
+	paramTagValue = "param"
+	paramTagSkip  = "-"
+)
+
+// caseName returns name of the table test case.
+// If the single param implements NamedParam, its name is used as is.
+// Otherwise, the name is <paramName>_<param1>_<param2>..., where every param is named with NamedParam,
+// formatted with fmt.Stringer if implemented or with %+v.
+func caseName(paramName string, params ...interface{}) string {
+	if len(params) == 1 {
+		if named, ok := params[0].(NamedParam); ok {
+			return truncateCaseName(named.Name())
+		}
+	}
+	name := paramName
+	for _, param := range params {
+		switch p := param.(type) {
+		case NamedParam:
+			name += "_" + p.Name()
+		case fmt.Stringer:
+			name += "_" + p.String()
+		default:
+			name += fmt.Sprintf("_%+v", param)
+		}
+	}
+	return truncateCaseName(name)
+}
+
+// truncateCaseName cuts the name to maxCaseNameLen bytes on the rune boundary
+func truncateCaseName(name string) string {
+	if len(name) <= maxCaseNameLen {
+		return name
+	}
+	end := maxCaseNameLen
+	for end > 0 && !utf8.RuneStart(name[end]) {
+		end--
+	}
+	return name[:end]
+}
+
+// caseAllureID returns ALLURE_ID of the table test case, if its single param implements AllureIDParam
+func caseAllureID(params ...interface{}) string {
+	if len(params) != 1 {
+		return ""
+	}
+	if param, ok := params[0].(AllureIDParam); ok {
+		return param.AllureID()
+	}
+	return ""
+}
+
+// caseNames keeps names of the table test cases unique.
+// Repeated name gets #01, #02... suffix in order the cases are declared, like go test does for subtests.
+type caseNames map[string]bool
+
+func (names caseNames) unique(name string) string {
+	if !names[name] {
+		names[name] = true
+		return name
+	}
+	for i := 1; ; i++ {
+		suffixed := fmt.Sprintf("%s#%02d", name, i)
+		if !names[suffixed] {
+			names[suffixed] = true
+			return suffixed
+		}
+	}
+}
+
+// caseParameters returns allure parameters describing the param of the table test case.
+// Exported fields of struct param become parameters. If some fields are tagged with `allure:"param"`,
+// only they are used. Fields tagged with `allure:"-"` are skipped.
+// Any other param becomes single parameter named paramName.
+func caseParameters(paramName string, param interface{}) []*allure.Parameter {
+	value := reflect.ValueOf(param)
+	for value.Kind() == reflect.Ptr || value.Kind() == reflect.Interface {
+		if value.IsNil() {
+			return []*allure.Parameter{allure.NewParameter(paramName, param)}
+		}
+		value = value.Elem()
+	}
+	if value.Kind() != reflect.Struct {
+		return []*allure.Parameter{allure.NewParameter(paramName, param)}
+	}
+
+	var (
+		valueType = value.Type()
+		tagged    []*allure.Parameter
+		exported  []*allure.Parameter
+	)
+	for i := 0; i < valueType.NumField(); i++ {
+		field := valueType.Field(i)
+		if field.PkgPath != "" {
+			continue
+		}
+		tag := field.Tag.Get(paramTagName)
+		if tag == paramTagSkip {
+			continue
+		}
+		parameter := allure.NewParameter(field.Name, value.Field(i).Interface())
+		if tag == paramTagValue {
+			tagged = append(tagged, parameter)
+		}
+		exported = append(exported, parameter)
+	}
+	if len(tagged) > 0 {
+		return tagged
+	}
+	return exported
+}
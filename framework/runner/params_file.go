@@ -0,0 +1,284 @@
+package runner
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/louisun/allure-go-v2/allure"
+	"gopkg.in/yaml.v3"
+)
+
+// fileRow is a row of the file with cases of the table test
+type fileRow struct {
+	// keys keep order of the values in the file
+	keys   []string
+	values map[string]string
+	decode func(target interface{}) error
+}
+
+// fileCases reads cases of the table test from JSON, YAML or CSV file.
+// Every row of the file is decoded into the param of the test, its keys and values become allure parameters.
+func fileCases(paramName, file string, argType reflect.Type) ([]tableCase, error) {
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read params of %s%s: %w", tableTestPrefix, paramName, err)
+	}
+
+	var rows []fileRow
+	switch strings.ToLower(filepath.Ext(file)) {
+	case ".json":
+		rows, err = jsonRows(data)
+	case ".yaml", ".yml":
+		rows, err = yamlRows(data)
+	case ".csv":
+		rows, err = csvRows(data)
+	default:
+		err = fmt.Errorf("unsupported format")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse params of %s%s from %s: %w", tableTestPrefix, paramName, file, err)
+	}
+
+	cases := make([]tableCase, 0, len(rows))
+	for i, row := range rows {
+		arg := reflect.New(argType)
+		if err = row.decode(arg.Interface()); err != nil {
+			return nil, fmt.Errorf("failed to decode row %d of %s: %w", i+1, file, err)
+		}
+		var params []*allure.Parameter
+		for _, key := range row.keys {
+			name := key
+			if name == "" {
+				// the row is not an object, so it is named after the test
+				name = paramName
+			}
+			params = append(params, allure.NewParameter(name, row.values[key]))
+		}
+		cases = append(cases, tableCase{
+			name:     caseName(paramName, arg.Elem().Interface()),
+			allureID: caseAllureID(arg.Elem().Interface()),
+			args:     []reflect.Value{arg.Elem()},
+			params:   params,
+		})
+	}
+	return cases, nil
+}
+
+// jsonRows parses JSON array. Keys of the objects are kept in order they are written
+func jsonRows(data []byte) ([]fileRow, error) {
+	var items []json.RawMessage
+	if err := json.Unmarshal(data, &items); err != nil {
+		return nil, err
+	}
+	rows := make([]fileRow, 0, len(items))
+	for _, item := range items {
+		item := item
+		row := fileRow{
+			values: make(map[string]string),
+			decode: func(target interface{}) error { return json.Unmarshal(item, target) },
+		}
+		dec := json.NewDecoder(bytes.NewReader(item))
+		if token, err := dec.Token(); err != nil || token != json.Delim('{') {
+			row.keys = []string{""}
+			row.values[""] = jsonValue(item)
+			rows = append(rows, row)
+			continue
+		}
+		for dec.More() {
+			token, err := dec.Token()
+			if err != nil {
+				return nil, err
+			}
+			var value json.RawMessage
+			if err = dec.Decode(&value); err != nil {
+				return nil, err
+			}
+			key := token.(string)
+			row.keys = append(row.keys, key)
+			row.values[key] = jsonValue(value)
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+// jsonValue returns JSON string unquoted and any other JSON value as is
+func jsonValue(raw json.RawMessage) string {
+	var s string
+	if err := json.Unmarshal(raw, &s); err == nil {
+		return s
+	}
+	return string(raw)
+}
+
+// yamlRows parses YAML sequence. Keys of the mappings are kept in order they are written
+func yamlRows(data []byte) ([]fileRow, error) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, err
+	}
+	if len(doc.Content) == 0 {
+		return nil, nil
+	}
+	seq := doc.Content[0]
+	if seq.Kind != yaml.SequenceNode {
+		return nil, fmt.Errorf("sequence of cases expected")
+	}
+	rows := make([]fileRow, 0, len(seq.Content))
+	for _, item := range seq.Content {
+		row := fileRow{values: make(map[string]string), decode: item.Decode}
+		if item.Kind != yaml.MappingNode {
+			row.keys = []string{""}
+			row.values[""] = yamlValue(item)
+			rows = append(rows, row)
+			continue
+		}
+		for i := 0; i+1 < len(item.Content); i += 2 {
+			key := item.Content[i].Value
+			row.keys = append(row.keys, key)
+			row.values[key] = yamlValue(item.Content[i+1])
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+// yamlValue returns scalar value as is and any other YAML value as flow-styled text
+func yamlValue(node *yaml.Node) string {
+	if node.Kind == yaml.ScalarNode {
+		return node.Value
+	}
+	var value interface{}
+	if err := node.Decode(&value); err != nil {
+		return ""
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+	return string(data)
+}
+
+// csvRows parses CSV file. The first line is header with names of the values
+func csvRows(data []byte) ([]fileRow, error) {
+	records, err := csv.NewReader(bytes.NewReader(data)).ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(records) == 0 {
+		return nil, nil
+	}
+	header := records[0]
+	rows := make([]fileRow, 0, len(records)-1)
+	for _, record := range records[1:] {
+		values := make(map[string]string, len(header))
+		for i, key := range header {
+			values[key] = record[i]
+		}
+		rows = append(rows, fileRow{
+			keys:   header,
+			values: values,
+			decode: func(target interface{}) error { return decodeCSV(header, values, reflect.ValueOf(target).Elem()) },
+		})
+	}
+	return rows, nil
+}
+
+// decodeCSV sets values of the CSV row to the target.
+// Struct fields are matched to the columns by json tag or by name case-insensitively.
+// Target of other kinds gets the value of the single column.
+func decodeCSV(header []string, values map[string]string, target reflect.Value) error {
+	if target.Kind() == reflect.Ptr {
+		target.Set(reflect.New(target.Type().Elem()))
+		return decodeCSV(header, values, target.Elem())
+	}
+	switch target.Kind() {
+	case reflect.Struct:
+		targetType := target.Type()
+		for _, key := range header {
+			for i := 0; i < targetType.NumField(); i++ {
+				field := targetType.Field(i)
+				if field.PkgPath != "" || !csvFieldMatches(field, key) {
+					continue
+				}
+				if err := setCSVValue(target.Field(i), values[key]); err != nil {
+					return fmt.Errorf("column %s: %w", key, err)
+				}
+				break
+			}
+		}
+		return nil
+	case reflect.Map:
+		if target.Type().Key().Kind() != reflect.String {
+			return fmt.Errorf("cannot decode CSV row into %s", target.Type())
+		}
+		target.Set(reflect.MakeMap(target.Type()))
+		for _, key := range header {
+			value := reflect.New(target.Type().Elem()).Elem()
+			if err := setCSVValue(value, values[key]); err != nil {
+				return fmt.Errorf("column %s: %w", key, err)
+			}
+			target.SetMapIndex(reflect.ValueOf(key).Convert(target.Type().Key()), value)
+		}
+		return nil
+	default:
+		if len(header) != 1 {
+			return fmt.Errorf("cannot decode %d CSV columns into %s", len(header), target.Type())
+		}
+		return setCSVValue(target, values[header[0]])
+	}
+}
+
+func csvFieldMatches(field reflect.StructField, key string) bool {
+	if name := strings.Split(field.Tag.Get("json"), ",")[0]; name != "" {
+		return name == key
+	}
+	return strings.EqualFold(field.Name, key)
+}
+
+// setCSVValue converts CSV value to the kind of the target. Values of composite kinds are decoded as JSON
+func setCSVValue(target reflect.Value, value string) error {
+	switch target.Kind() {
+	case reflect.String:
+		target.SetString(value)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return err
+		}
+		target.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i, err := strconv.ParseInt(value, 10, target.Type().Bits())
+		if err != nil {
+			return err
+		}
+		target.SetInt(i)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		u, err := strconv.ParseUint(value, 10, target.Type().Bits())
+		if err != nil {
+			return err
+		}
+		target.SetUint(u)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(value, target.Type().Bits())
+		if err != nil {
+			return err
+		}
+		target.SetFloat(f)
+	case reflect.Interface:
+		if !reflect.TypeOf(value).AssignableTo(target.Type()) {
+			return fmt.Errorf("cannot decode CSV value into %s", target.Type())
+		}
+		target.Set(reflect.ValueOf(value))
+	default:
+		return json.Unmarshal([]byte(value), target.Addr().Interface())
+	}
+	return nil
+}
@@ -0,0 +1,192 @@
+package runner
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"unsafe"
+
+	"github.com/louisun/allure-go-v2/allure"
+	"github.com/louisun/allure-go-v2/framework/provider"
+)
+
+const (
+	// paramDimSeparator separates name of the table test and name of the dimension in Param<Name>_<Dimension> fields
+	paramDimSeparator = "_"
+	// paramFileTagPrefix is prefix of the `allure:"file=<path>"` tag of Param<Name> field
+	paramFileTagPrefix = "file="
+
+	testdataDir = "testdata"
+)
+
+var (
+	testingTType   = reflect.TypeOf((*provider.T)(nil)).Elem()
+	testdataFormat = []string{".json", ".yaml", ".yml", ".csv"}
+)
+
+// tableCase describes a case of the table test
+type tableCase struct {
+	name     string
+	allureID string
+	args     []reflect.Value
+	params   []*allure.Parameter
+}
+
+// tableCasesCollector returns cases of the table test
+type tableCasesCollector func() ([]tableCase, error)
+
+// findTableCases finds the source of cases for TableTest<Name> method of the suite. Sources are checked in order:
+//   - Param<Name> method, returning a slice for every param of the test. Cases are product of the slices.
+//     Method is called after BeforeAll hook, so deferred is true;
+//   - Param<Name> slice field for the test with single param. Field tagged `allure:"file=<path>"` is read from the file;
+//   - Param<Name>_<Dimension> slice fields for the test with several params. Cases are product of the fields;
+//   - testdata/<Name>.json, .yaml, .yml or .csv file for the test with single param.
+func findTableCases(suite TestSuite, method reflect.Method) (collect tableCasesCollector, deferred bool, err error) {
+	var (
+		paramName   = strings.TrimPrefix(method.Name, tableTestPrefix)
+		sourceName  = tableParamPrefix + paramName
+		suiteValue  = reflect.ValueOf(suite)
+		structSuite = suiteValue.Elem()
+		argTypes    []reflect.Type
+	)
+	// receiver and provider.T go first
+	if method.Type.NumIn() < 3 || !method.Type.In(1).Implements(testingTType) && method.Type.In(1) != testingTType {
+		return nil, false, fmt.Errorf("table test %s must accept provider.T and at least one param", method.Name)
+	}
+	for i := 2; i < method.Type.NumIn(); i++ {
+		argTypes = append(argTypes, method.Type.In(i))
+	}
+
+	if paramMethod, ok := suiteValue.Type().MethodByName(sourceName); ok {
+		dimNames := make([]string, len(argTypes))
+		for i := range dimNames {
+			dimNames[i] = paramName
+			if len(argTypes) > 1 {
+				dimNames[i] = fmt.Sprintf("%s%d", paramName, i+1)
+			}
+		}
+		return func() ([]tableCase, error) {
+			slices := paramMethod.Func.Call([]reflect.Value{suiteValue})
+			return productCases(paramName, dimNames, slices, argTypes)
+		}, true, nil
+	}
+
+	if len(argTypes) == 1 {
+		if field, ok := structSuite.Type().FieldByName(sourceName); ok {
+			if file := paramFileTag(field.Tag); file != "" {
+				return func() ([]tableCase, error) {
+					return fileCases(paramName, file, argTypes[0])
+				}, false, nil
+			}
+			slice := exportedField(structSuite, field)
+			return func() ([]tableCase, error) {
+				return productCases(paramName, []string{paramName}, []reflect.Value{slice}, argTypes)
+			}, false, nil
+		}
+		if file := findTestdataFile(paramName); file != "" {
+			return func() ([]tableCase, error) {
+				return fileCases(paramName, file, argTypes[0])
+			}, false, nil
+		}
+		return nil, false, fmt.Errorf("cannot find appropriate params for %s", method.Name)
+	}
+
+	var (
+		dimNames []string
+		slices   []reflect.Value
+	)
+	for i := 0; i < structSuite.NumField(); i++ {
+		field := structSuite.Type().Field(i)
+		if strings.HasPrefix(field.Name, sourceName+paramDimSeparator) {
+			dimNames = append(dimNames, strings.TrimPrefix(field.Name, sourceName+paramDimSeparator))
+			slices = append(slices, exportedField(structSuite, field))
+		}
+	}
+	if len(slices) != len(argTypes) {
+		return nil, false, fmt.Errorf("table test %s has %d params, but %d %s%s<Dimension> fields found",
+			method.Name, len(argTypes), len(slices), sourceName, paramDimSeparator)
+	}
+	return func() ([]tableCase, error) {
+		return productCases(paramName, dimNames, slices, argTypes)
+	}, false, nil
+}
+
+// exportedField returns value of the field of the struct, which can be used even if the field is unexported
+func exportedField(structValue reflect.Value, field reflect.StructField) reflect.Value {
+	value := structValue.FieldByIndex(field.Index)
+	return reflect.NewAt(value.Type(), unsafe.Pointer(value.UnsafeAddr())).Elem()
+}
+
+func paramFileTag(tag reflect.StructTag) string {
+	for _, option := range strings.Split(tag.Get(paramTagName), ",") {
+		if strings.HasPrefix(option, paramFileTagPrefix) {
+			return strings.TrimPrefix(option, paramFileTagPrefix)
+		}
+	}
+	return ""
+}
+
+// findTestdataFile returns path of testdata/<paramName> file of supported format, if it exists
+func findTestdataFile(paramName string) string {
+	for _, ext := range testdataFormat {
+		file := filepath.Join(testdataDir, paramName+ext)
+		if _, err := os.Stat(file); err == nil {
+			return file
+		}
+	}
+	return ""
+}
+
+// productCases returns cases for every combination of the slices elements. The first slice changes slowest.
+func productCases(paramName string, dimNames []string, slices []reflect.Value, argTypes []reflect.Type) ([]tableCase, error) {
+	if len(slices) != len(argTypes) {
+		return nil, fmt.Errorf("%d param slices found for %s, but table test has %d params", len(slices), paramName, len(argTypes))
+	}
+	total := 1
+	for i, slice := range slices {
+		if slice.Kind() != reflect.Slice && slice.Kind() != reflect.Array {
+			return nil, fmt.Errorf("cannot find appropriate params for %s%s: %s is not a slice", tableTestPrefix, paramName, dimNames[i])
+		}
+		if !slice.Type().Elem().AssignableTo(argTypes[i]) {
+			return nil, fmt.Errorf("params %s of type %s cannot be passed to %s%s as %s",
+				dimNames[i], slice.Type(), tableTestPrefix, paramName, argTypes[i])
+		}
+		total *= slice.Len()
+	}
+
+	cases := make([]tableCase, 0, total)
+	for n := 0; n < total; n++ {
+		var (
+			args   = make([]reflect.Value, len(slices))
+			values = make([]interface{}, len(slices))
+			params []*allure.Parameter
+			rest   = n
+		)
+		for i := len(slices) - 1; i >= 0; i-- {
+			args[i] = slices[i].Index(rest % slices[i].Len())
+			rest /= slices[i].Len()
+		}
+		for i, arg := range args {
+			values[i] = arg.Interface()
+			params = append(params, caseParameters(dimNames[i], values[i])...)
+		}
+		cases = append(cases, tableCase{
+			name:     caseName(paramName, values...),
+			allureID: caseAllureID(values...),
+			args:     args,
+			params:   params,
+		})
+	}
+	return cases, nil
+}
+
+// uniqueCaseNames adds suffix to repeated names of the cases
+func uniqueCaseNames(cases []tableCase) []tableCase {
+	names := make(caseNames)
+	for i := range cases {
+		cases[i].name = names.unique(cases[i].name)
+	}
+	return cases
+}
@@ -0,0 +1,103 @@
+package runner
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/louisun/allure-go-v2/allure"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCaseName_product(t *testing.T) {
+	require.Equal(t, "Matrix_1_a", caseName("Matrix", 1, "a"))
+	require.Equal(t, "Matrix_city Moscow_code", caseName("Matrix", namedParam{City: "Moscow"}, &stringerParam{}))
+}
+
+func TestProductCases(t *testing.T) {
+	var (
+		numbers = reflect.ValueOf([]int{1, 2})
+		letters = reflect.ValueOf([]string{"a", "b", "c"})
+		types   = []reflect.Type{reflect.TypeOf(0), reflect.TypeOf("")}
+	)
+	cases, err := productCases("Matrix", []string{"Number", "Letter"}, []reflect.Value{numbers, letters}, types)
+	require.NoError(t, err)
+	require.Len(t, cases, 6)
+
+	var names []string
+	for _, c := range cases {
+		names = append(names, c.name)
+	}
+	require.Equal(t, []string{"Matrix_1_a", "Matrix_1_b", "Matrix_1_c", "Matrix_2_a", "Matrix_2_b", "Matrix_2_c"}, names)
+	require.Equal(t, []*allure.Parameter{
+		allure.NewParameter("Number", 2),
+		allure.NewParameter("Letter", "b"),
+	}, cases[4].params)
+	require.Equal(t, 2, cases[4].args[0].Interface())
+	require.Equal(t, "b", cases[4].args[1].Interface())
+
+	_, err = productCases("Matrix", []string{"Number", "Letter"}, []reflect.Value{letters, numbers}, types)
+	require.Error(t, err)
+	_, err = productCases("Matrix", []string{"Number"}, []reflect.Value{numbers}, types)
+	require.Error(t, err)
+}
+
+type fileCase struct {
+	City       string `json:"city" yaml:"city"`
+	Population int    `json:"population" yaml:"population"`
+}
+
+func writeParamsFile(t *testing.T, name, content string) string {
+	file := filepath.Join(t.TempDir(), name)
+	require.NoError(t, ioutil.WriteFile(file, []byte(content), os.ModePerm))
+	return file
+}
+
+func TestFileCases(t *testing.T) {
+	files := map[string]string{
+		"cities.json": `[{"population": 12, "city": "Moscow"}, {"population": 1, "city": "Kazan"}]`,
+		"cities.yaml": "- population: 12\n  city: Moscow\n- population: 1\n  city: Kazan\n",
+		"cities.csv":  "population,city\n12,Moscow\n1,Kazan\n",
+	}
+	for name, content := range files {
+		t.Run(name, func(t *testing.T) {
+			cases, err := fileCases("Cities", writeParamsFile(t, name, content), reflect.TypeOf(fileCase{}))
+			require.NoError(t, err)
+			require.Len(t, cases, 2)
+
+			require.Equal(t, fileCase{City: "Moscow", Population: 12}, cases[0].args[0].Interface())
+			require.Equal(t, fileCase{City: "Kazan", Population: 1}, cases[1].args[0].Interface())
+			require.Equal(t, "Cities_{City:Kazan Population:1}", cases[1].name)
+			require.Equal(t, []*allure.Parameter{
+				allure.NewParameter("population", "12"),
+				allure.NewParameter("city", "Moscow"),
+			}, cases[0].params)
+		})
+	}
+}
+
+func TestFileCases_scalars(t *testing.T) {
+	cases, err := fileCases("Numbers", writeParamsFile(t, "numbers.json", `[1, 2]`), reflect.TypeOf(0))
+	require.NoError(t, err)
+	require.Len(t, cases, 2)
+	require.Equal(t, "Numbers_2", cases[1].name)
+	require.Equal(t, []*allure.Parameter{allure.NewParameter("Numbers", "2")}, cases[1].params)
+
+	cases, err = fileCases("Numbers", writeParamsFile(t, "numbers.csv", "number\n3\n"), reflect.TypeOf(0))
+	require.NoError(t, err)
+	require.Equal(t, 3, cases[0].args[0].Interface())
+
+	cases, err = fileCases("Values", writeParamsFile(t, "values.csv", "value\nx\n"), reflect.TypeOf(map[string]interface{}{}))
+	require.NoError(t, err)
+	require.Equal(t, map[string]interface{}{"value": "x"}, cases[0].args[0].Interface())
+	_, err = fileCases("Values", writeParamsFile(t, "values.csv", "value\nx\n"), reflect.TypeOf(map[string]fmt.Stringer{}))
+	require.Error(t, err)
+
+	_, err = fileCases("Numbers", writeParamsFile(t, "numbers.json", `[{"number": "x"}]`), reflect.TypeOf(0))
+	require.Error(t, err)
+	_, err = fileCases("Numbers", writeParamsFile(t, "numbers.txt", "1"), reflect.TypeOf(0))
+	require.Error(t, err)
+}
@@ -0,0 +1,79 @@
+package runner
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/louisun/allure-go-v2/allure"
+	"github.com/stretchr/testify/require"
+)
+
+type namedParam struct {
+	City string
+}
+
+func (p namedParam) Name() string {
+	return "city " + p.City
+}
+
+type stringerParam struct {
+	code int
+}
+
+func (p *stringerParam) String() string {
+	return "code"
+}
+
+type taggedParam struct {
+	Country string `allure:"param"`
+	Year    int    `allure:"param"`
+	Payload string
+	secret  string
+}
+
+type plainParam struct {
+	Country string
+	Skipped string `allure:"-"`
+	number  int
+}
+
+func TestCaseName(t *testing.T) {
+	require.Equal(t, "city Moscow", caseName("Cities", namedParam{City: "Moscow"}))
+	require.Equal(t, "Codes_code", caseName("Codes", &stringerParam{code: 1}))
+	require.Equal(t, "Numbers_42", caseName("Numbers", 42))
+	require.Equal(t, "Plain_{Country:RU Skipped: number:0}", caseName("Plain", plainParam{Country: "RU"}))
+	require.Len(t, caseName("Long", strings.Repeat("a", 200)), maxCaseNameLen)
+
+	name := caseName("Город", strings.Repeat("я", 100))
+	require.True(t, utf8.ValidString(name))
+	require.Equal(t, "Город_"+strings.Repeat("я", 69), name)
+}
+
+func TestCaseNames_unique(t *testing.T) {
+	names := make(caseNames)
+	require.Equal(t, "name", names.unique("name"))
+	require.Equal(t, "name#01", names.unique("name"))
+	require.Equal(t, "name#02", names.unique("name"))
+	require.Equal(t, "other", names.unique("other"))
+	require.Equal(t, "name#03", names.unique("name#01"[:4]))
+}
+
+func TestCaseParameters(t *testing.T) {
+	params := caseParameters("Tagged", &taggedParam{Country: "RU", Year: 1990, Payload: "{}", secret: "s"})
+	require.Equal(t, []*allure.Parameter{
+		allure.NewParameter("Country", "RU"),
+		allure.NewParameter("Year", 1990),
+	}, params)
+
+	params = caseParameters("Plain", plainParam{Country: "RU", Skipped: "skip", number: 1})
+	require.Equal(t, []*allure.Parameter{allure.NewParameter("Country", "RU")}, params)
+
+	params = caseParameters("Numbers", 42)
+	require.Equal(t, []*allure.Parameter{allure.NewParameter("Numbers", 42)}, params)
+
+	var nilParam *taggedParam
+	params = caseParameters("Nil", nilParam)
+	require.Len(t, params, 1)
+	require.Equal(t, "Nil", params[0].Name)
+}
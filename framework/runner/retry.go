@@ -0,0 +1,208 @@
+package runner
+
+import (
+	"flag"
+	"fmt"
+	"runtime"
+	"sync"
+	"time"
+
+	"github.com/louisun/allure-go-v2/allure"
+)
+
+var retriesCount = flag.Int("allure-go.retries", 0, "number of times a failed test of the allure-go suite will be retried")
+
+// suiteMu guards updates of the suite container made by parallel test attempts
+var suiteMu sync.Mutex
+
+// attemptT wraps TestingT of the test to run a single test attempt.
+// If record is true, failures and skips of the attempt are recorded by attemptT
+// instead of being reported to the TestingT, so the next attempt can be run.
+// t.Parallel() is called no more than once for all attempts of the test.
+type attemptT struct {
+	TestingT
+
+	record   bool
+	parallel *sync.Once
+
+	mu      sync.RWMutex
+	failed  bool
+	skipped bool
+	skipMsg string
+}
+
+func newAttemptT(t TestingT, parallel *sync.Once, record bool) *attemptT {
+	return &attemptT{TestingT: t, parallel: parallel, record: record}
+}
+
+// run runs attempt body. Recorded attempts are run in their own goroutine,
+// because FailNow and SkipNow stop it with runtime.Goexit
+func (t *attemptT) run(body func()) {
+	if !t.record {
+		body()
+		return
+	}
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		body()
+	}()
+	<-done
+}
+
+// Parallel ...
+func (t *attemptT) Parallel() {
+	t.parallel.Do(t.TestingT.Parallel)
+}
+
+// Fail ...
+func (t *attemptT) Fail() {
+	if !t.record {
+		t.TestingT.Fail()
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.failed = true
+}
+
+// FailNow ...
+func (t *attemptT) FailNow() {
+	if !t.record {
+		t.TestingT.FailNow()
+		return
+	}
+	t.Fail()
+	runtime.Goexit()
+}
+
+// Failed ...
+func (t *attemptT) Failed() bool {
+	if !t.record {
+		return t.TestingT.Failed()
+	}
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return t.failed
+}
+
+// Error ...
+func (t *attemptT) Error(args ...interface{}) {
+	t.TestingT.Helper()
+
+	if !t.record {
+		t.TestingT.Error(args...)
+		return
+	}
+	t.TestingT.Log(args...)
+	t.Fail()
+}
+
+// Errorf ...
+func (t *attemptT) Errorf(format string, args ...interface{}) {
+	t.TestingT.Helper()
+
+	if !t.record {
+		t.TestingT.Errorf(format, args...)
+		return
+	}
+	t.TestingT.Logf(format, args...)
+	t.Fail()
+}
+
+// Fatal ...
+func (t *attemptT) Fatal(args ...interface{}) {
+	t.TestingT.Helper()
+
+	if !t.record {
+		t.TestingT.Fatal(args...)
+		return
+	}
+	t.TestingT.Log(args...)
+	t.FailNow()
+}
+
+// Fatalf ...
+func (t *attemptT) Fatalf(format string, args ...interface{}) {
+	t.TestingT.Helper()
+
+	if !t.record {
+		t.TestingT.Fatalf(format, args...)
+		return
+	}
+	t.TestingT.Logf(format, args...)
+	t.FailNow()
+}
+
+// Skip ...
+func (t *attemptT) Skip(args ...interface{}) {
+	t.TestingT.Helper()
+
+	if !t.record {
+		t.TestingT.Skip(args...)
+		return
+	}
+	t.skip(fmt.Sprintln(args...))
+}
+
+// Skipf ...
+func (t *attemptT) Skipf(format string, args ...interface{}) {
+	t.TestingT.Helper()
+
+	if !t.record {
+		t.TestingT.Skipf(format, args...)
+		return
+	}
+	t.skip(fmt.Sprintf(format, args...))
+}
+
+// SkipNow ...
+func (t *attemptT) SkipNow() {
+	if !t.record {
+		t.TestingT.SkipNow()
+		return
+	}
+	t.skip("")
+}
+
+// Skipped ...
+func (t *attemptT) Skipped() bool {
+	if !t.record {
+		return t.TestingT.Skipped()
+	}
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return t.skipped
+}
+
+// Deadline ...
+func (t *attemptT) Deadline() (time.Time, bool) {
+	if d, ok := t.TestingT.(interface{ Deadline() (time.Time, bool) }); ok {
+		return d.Deadline()
+	}
+	return time.Time{}, false
+}
+
+func (t *attemptT) skip(msg string) {
+	t.mu.Lock()
+	t.skipped = true
+	t.skipMsg = msg
+	t.mu.Unlock()
+	runtime.Goexit()
+}
+
+// isPassed returns true if test attempt neither failed nor broken
+func isPassed(t TestingT, result *allure.Result) bool {
+	if t.Failed() {
+		return false
+	}
+	switch result.Status {
+	case "", allure.Passed, allure.Skipped:
+		return true
+	}
+	return false
+}
+
+func defaultRetries(string) int {
+	return *retriesCount
+}
@@ -7,19 +7,37 @@ import (
 	"strings"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/louisun/allure-go-v2/allure"
 	"github.com/louisun/allure-go-v2/framework/core/allure_manager/adapter"
 	"github.com/louisun/allure-go-v2/framework/core/allure_manager/manager"
+	"github.com/louisun/allure-go-v2/framework/core/allure_manager/rerun"
 	"github.com/louisun/allure-go-v2/framework/core/allure_manager/testplan"
 	"github.com/louisun/allure-go-v2/framework/core/common"
 	"github.com/louisun/allure-go-v2/framework/provider"
 )
 
 type runner struct {
-	internalT internalT
-	testPlan  *testplan.TestPlan
-	tests     map[string]Test
+	internalT   internalT
+	testPlan    *testplan.TestPlan
+	failedTests *rerun.FailedTests
+	tests       map[string]Test
+	retries     func(testName string) int
+	timeout     time.Duration
+	// allureIDs are ALLURE_ID of the tests and cases set by AddAllureIDMapping
+	allureIDs map[string]string
+	// parametrized are names of the tests added by NewParametrizedTest
+	parametrized map[string]bool
+
+	// order keeps names of the tests in order they were declared in
+	order []string
+	// declaredOrder is the order of the tests set by the suite
+	declaredOrder []string
+	// deferred add tests, which can be collected only after BeforeAll hook
+	deferred []func() error
+	// parentContainers are containers of the parent suites, which hooks wrap the tests
+	parentContainers []*allure.Container
 }
 
 func NewRunner(realT TestingT, suiteName string) TestRunner {
@@ -34,7 +52,16 @@ func NewRunner(realT TestingT, suiteName string) TestRunner {
 	newT.SetProvider(manager.NewProvider(providerCfg))
 
 	testPlan := testplan.GetTestPlan()
-	return &runner{internalT: newT, tests: make(map[string]Test), testPlan: testPlan}
+	return &runner{
+		internalT:   newT,
+		tests:       make(map[string]Test),
+		testPlan:    testPlan,
+		failedTests: rerun.GetFailedTests(),
+		retries:     defaultRetries,
+		timeout:     *testTimeout,
+
+		parentContainers: launchContainers(),
+	}
 }
 
 func (r *runner) t() internalT {
@@ -47,7 +74,7 @@ func (r *runner) realT() TestingT {
 
 func (r *runner) toRun(result *allure.Result) bool {
 	if r.testPlan != nil {
-		return r.testPlan.IsSelected(result.TestCaseID, result.FullName)
+		return r.testPlan.IsResultSelected(result)
 	}
 	return true
 }
@@ -56,7 +83,7 @@ func (r *runner) filterByTestPlan() map[string]Test {
 	if plan := r.testPlan; plan != nil {
 		tests := make(map[string]Test)
 		for fullName, testData := range r.tests {
-			if r.testPlan.IsSelected(testData.GetMeta().GetResult().TestCaseID, testData.GetMeta().GetResult().FullName) {
+			if r.testPlan.IsResultSelected(testData.GetMeta().GetResult()) {
 				tests[fullName] = testData
 			}
 		}
@@ -65,6 +92,58 @@ func (r *runner) filterByTestPlan() map[string]Test {
 	return r.tests
 }
 
+// filterFailed leaves tests failed in the run set by -allure-go.rerun-failed flag
+func (r *runner) filterFailed() map[string]Test {
+	if r.failedTests == nil {
+		return r.tests
+	}
+	tests := make(map[string]Test)
+	for fullName, testData := range r.tests {
+		if r.failedTests.Select(testData.GetMeta().GetResult()) {
+			tests[fullName] = testData
+		}
+	}
+	return tests
+}
+
+// filterTests leaves tests selected by the testplan, -allure-go.rerun-failed, -allure-go.select and -allure-go.shard
+func (r *runner) filterTests(result SuiteResult) {
+	r.tests = r.filterByTestPlan()
+	r.tests = r.filterFailed()
+	r.tests = r.filterBySelector(result)
+	r.tests = r.filterByShard()
+}
+
+// collectDeferred collects tests, which could not be collected before BeforeAll hook, and filters them
+func (r *runner) collectDeferred(result SuiteResult) (err error) {
+	if len(r.deferred) == 0 {
+		return nil
+	}
+	// only new tests are filtered, the collected ones have been filtered already
+	collected := r.tests
+	r.tests = make(map[string]Test)
+	defer func() {
+		if rec := recover(); rec != nil {
+			err = fmt.Errorf("failed to collect tests of suite %s: %v", r.t().Name(), rec)
+		}
+		r.deferred = nil
+		if err != nil {
+			r.tests = make(map[string]Test)
+		} else {
+			r.filterTests(result)
+		}
+		for name, test := range collected {
+			r.tests[name] = test
+		}
+	}()
+	for _, collect := range r.deferred {
+		if err = collect(); err != nil {
+			return fmt.Errorf("failed to collect tests of suite %s: %w", r.t().Name(), err)
+		}
+	}
+	return nil
+}
+
 func (r *runner) NewTest(testName string, testBody func(provider.T), tags ...string) {
 	fullName := fmt.Sprintf("%s/%s", r.t().Name(), testName)
 
@@ -76,27 +155,68 @@ func (r *runner) NewTest(testName string, testBody func(provider.T), tags ...str
 		tags...,
 	)
 
+	if id, ok := r.allureIDs[testName]; ok {
+		testMeta.GetResult().AddLabel(allure.IDAllureLabel(id))
+	}
+
 	if !r.toRun(testMeta.GetResult()) {
 		return
 	}
 
-	r.tests[fullName] = newTestFunc(testBody, testMeta)
+	r.addTest(fullName, newTestFunc(testBody, testMeta))
+}
+
+// AddAllureIDMapping sets ALLURE_ID of the test or the case of the parametrized test, which is added after it.
+// Cases are mapped by their names, e.g. "Cities_Moscow". Mapping has priority over the case implementing AllureIDParam.
+func (r *runner) AddAllureIDMapping(testName, allureID string) {
+	if r.allureIDs == nil {
+		r.allureIDs = make(map[string]string)
+	}
+	r.allureIDs[testName] = allureID
 }
 
+// BeforeEach adds hook, which runs before each test. Hooks run in order they were added
 func (r *runner) BeforeEach(hookBody func(provider.T)) {
-	r.internalT.GetProvider().GetTestMeta().SetBeforeEach(hookBody)
+	r.WithBeforeEach("", hookBody)
 }
 
+// AfterEach adds hook, which runs after each test. Hooks run in reverse order they were added
 func (r *runner) AfterEach(hookBody func(provider.T)) {
-	r.internalT.GetProvider().GetTestMeta().SetAfterEach(hookBody)
+	r.WithAfterEach("", hookBody)
 }
 
+// BeforeAll adds hook, which runs before all tests. Hooks run in order they were added
 func (r *runner) BeforeAll(hookBody func(provider.T)) {
-	r.internalT.GetProvider().GetSuiteMeta().SetBeforeAll(hookBody)
+	r.WithBeforeAll("", hookBody)
 }
 
+// AfterAll adds hook, which runs after all tests. Hooks run in reverse order they were added
 func (r *runner) AfterAll(hookBody func(provider.T)) {
-	r.internalT.GetProvider().GetSuiteMeta().SetAfterAll(hookBody)
+	r.WithAfterAll("", hookBody)
+}
+
+// WithBeforeEach adds named hook, which runs before each test as its own sub-test and step
+func (r *runner) WithBeforeEach(name string, hookBody func(provider.T)) {
+	meta := r.internalT.GetProvider().GetTestMeta()
+	meta.SetBeforeEach(append(meta.GetBeforeEach(), provider.Hook{Name: name, Body: hookBody})...)
+}
+
+// WithAfterEach adds named hook, which runs after each test as its own sub-test and step
+func (r *runner) WithAfterEach(name string, hookBody func(provider.T)) {
+	meta := r.internalT.GetProvider().GetTestMeta()
+	meta.SetAfterEach(append(meta.GetAfterEach(), provider.Hook{Name: name, Body: hookBody})...)
+}
+
+// WithBeforeAll adds named hook, which runs before all tests as its own sub-test and step
+func (r *runner) WithBeforeAll(name string, hookBody func(provider.T)) {
+	meta := r.internalT.GetProvider().GetSuiteMeta()
+	meta.SetBeforeAll(append(meta.GetBeforeAll(), provider.Hook{Name: name, Body: hookBody})...)
+}
+
+// WithAfterAll adds named hook, which runs after all tests as its own sub-test and step
+func (r *runner) WithAfterAll(name string, hookBody func(provider.T)) {
+	meta := r.internalT.GetProvider().GetSuiteMeta()
+	meta.SetAfterAll(append(meta.GetAfterAll(), provider.Hook{Name: name, Body: hookBody})...)
 }
 
 func (r *runner) RunTests() SuiteResult {
@@ -118,13 +238,29 @@ func (r *runner) RunTests() SuiteResult {
 		r.t().SetRealT(t)
 		defer r.t().SetRealT(oldParentT)
 
-		r.tests = r.filterByTestPlan()
+		r.filterTests(result)
 
-		if len(r.tests) == 0 {
+		if len(r.tests) == 0 && len(r.deferred) == 0 {
+			if r.testPlan != nil {
+				r.t().Skipf("No tests of suite %s matched the testplan", r.t().Name())
+				return
+			}
 			r.t().Skipf("No tests to run for suite %s", r.t().Name())
 			return
 		}
 
+		if *dryRun {
+			// there is no BeforeAll hook in dry-run, so deferred tests are collected without it.
+			// Param<Name> methods of deferred table tests are still called to list their cases
+			if err := r.collectDeferred(result); err != nil {
+				r.t().Errorf(err.Error())
+			}
+			if err := r.runDry(result); err != nil {
+				r.t().Errorf(err.Error())
+			}
+			return
+		}
+
 		defer func() {
 			wg.Wait()
 			finishSuite(r.internalT.GetProvider())
@@ -155,6 +291,10 @@ func (r *runner) RunTests() SuiteResult {
 			return
 		}
 
+		if err = r.collectDeferred(result); err != nil {
+			r.t().Errorf(err.Error())
+		}
+
 		// THE MOST dirty hack in history
 		// t.Parallel() waits for parent-test reach its defer function
 		// Unfortunately it's impossible to reach this function if parent-test waits for other tests complete
@@ -165,45 +305,21 @@ func (r *runner) RunTests() SuiteResult {
 			r.t().SetRealT(t)
 			defer r.t().SetRealT(oldTestT)
 
-			for _, testData := range r.tests {
-				test := testData
+			for _, testName := range r.orderedTests() {
+				test := r.tests[testName]
+				run := &testRun{
+					parentProvider:   r.t().GetProvider(),
+					beforeEach:       beforeEachHook,
+					afterEach:        afterEachHook,
+					retries:          r.retries(testName),
+					timeout:          r.timeout,
+					result:           result,
+					parentContainers: r.parentContainers,
+				}
 				wg.Add(1)
 				r.realT().Run(test.GetMeta().GetResult().Begin().Name, func(t *testing.T) {
 					defer wg.Done()
-					defer func() {
-						result.NewResult(finishTest(t, test.GetMeta()))
-					}()
-					testT := setupTest(t, r.t().GetProvider(), test.GetMeta())
-
-					// after each hook
-					defer func() {
-						_, _ = runHook(testT, afterEachHook)
-					}()
-
-					// catch panic in test body context
-					defer func() {
-						rec := recover()
-						if rec != nil {
-							ctxName := testT.GetProvider().ExecutionContext().GetName()
-							errMsg := fmt.Sprintf("%s panicked: %v\n%s", ctxName, rec, debug.Stack())
-							common.TestError(testT, testT.GetProvider(), testT.GetProvider().ExecutionContext().GetName(), errMsg)
-						}
-					}()
-
-					// before each hook
-					ok, err = runHook(testT, beforeEachHook)
-					if err != nil {
-						result = setupErrorHandler("Test Setup failed", err, test.GetMeta(), result)
-						return
-					}
-					if !ok {
-						result = setupErrorHandler("Test Setup failed", fmt.Errorf("assertion error due test setup"), test.GetMeta(), result)
-						return
-					}
-
-					testT.GetProvider().TestContext()
-					defer testT.WG().Wait()
-					test.GetBody()(testT)
+					run.runWithRetries(t, test)
 				})
 			}
 		})
@@ -211,6 +327,103 @@ func (r *runner) RunTests() SuiteResult {
 	return result
 }
 
+// testRun describes how the test of the suite is run
+type testRun struct {
+	parentProvider provider.Provider
+	beforeEach     common.HookFunc
+	afterEach      common.HookFunc
+	retries        int
+	timeout        time.Duration
+	result         SuiteResult
+	// parentContainers are containers of the parent suites, which list every attempt of the test
+	parentContainers []*allure.Container
+}
+
+// runWithRetries runs the test and reruns it while it fails, but no more than retries times.
+// Each attempt is reported as a separate allure.Result with the same HistoryID,
+// so Allure shows previous attempts in the "Retries" tab.
+// Failures of all attempts except the last one are not reported to testing.T.
+func (run *testRun) runWithRetries(t *testing.T, test Test) {
+	var (
+		meta     = test.GetMeta()
+		template = meta.GetResult().NewAttempt()
+		parallel = &sync.Once{}
+	)
+	for attempt := 0; attempt <= run.retries; attempt++ {
+		if attempt > 0 {
+			meta = adapter.NewTestMetaWithResult(template.NewAttempt())
+			suiteMu.Lock()
+			run.parentProvider.GetSuiteMeta().GetContainer().AddChild(meta.GetResult().UUID)
+			suiteMu.Unlock()
+		}
+		linkToParents(run.parentContainers, meta.GetResult().UUID)
+
+		attemptMeta := meta
+		attemptT := newAttemptT(t, parallel, attempt < run.retries)
+		attemptT.run(func() {
+			run.runAttempt(attemptT, test, attemptMeta, attempt > 0)
+		})
+
+		if attemptT.Skipped() {
+			t.Skip(attemptT.skipMsg)
+		}
+		if isPassed(attemptT, attemptMeta.GetResult()) {
+			return
+		}
+		if attempt < run.retries {
+			t.Logf("Attempt %d of %d failed. Test will be retried", attempt+1, run.retries+1)
+		}
+	}
+}
+
+// runAttempt runs single attempt of the test with before/after each hooks
+func (run *testRun) runAttempt(t TestingT, test Test, meta provider.TestMeta, retried bool) {
+	var testT *common.Common
+	defer func() {
+		// goroutine abandoned by timeout may still report to the test
+		if testT != nil {
+			testT.Seal()
+		}
+		if retried && isPassed(t, meta.GetResult()) {
+			meta.GetResult().SetFlaky(true)
+		}
+		run.result.NewResult(finishTest(t, meta))
+	}()
+	testT = setupTest(t, run.parentProvider, meta)
+
+	// after each hook
+	defer func() {
+		_, _ = runHook(testT.Detached(), run.afterEach)
+	}()
+
+	// before each hook
+	ok, err := runHook(testT, run.beforeEach)
+	if err != nil {
+		setupErrorHandler("Test Setup failed", err, meta, run.result)
+		return
+	}
+	if !ok {
+		setupErrorHandler("Test Setup failed", fmt.Errorf("assertion error due test setup"), meta, run.result)
+		return
+	}
+
+	testT.GetProvider().TestContext()
+	testT.WithTimeout(run.timeout)
+	testT.ExecuteWithTimeout(func() {
+		// catch panic in test body context
+		defer func() {
+			rec := recover()
+			if rec != nil {
+				ctxName := testT.GetProvider().ExecutionContext().GetName()
+				errMsg := fmt.Sprintf("%s panicked: %v\n%s", ctxName, rec, debug.Stack())
+				common.TestError(testT, testT, testT.GetProvider().ExecutionContext().GetName(), errMsg)
+			}
+		}()
+		defer testT.WG().Wait()
+		test.GetBody()(testT)
+	})
+}
+
 func Run(t *testing.T, testName string, testBody func(provider.T), tags ...string) *allure.Result {
 	return RunWithSuite(t, "", testName, testBody, tags...)
 }
@@ -233,7 +446,11 @@ func RunWithSuite(t *testing.T, suiteName string, testName string, testBody func
 	newT.SetProvider(newProvider)
 	newT.Provider.TestContext()
 
-	return newT.Run(testName, testBody, tags...)
+	result := newT.Run(testName, testBody, tags...)
+	if result != nil {
+		linkToParents(launchContainers(), result.UUID)
+	}
+	return result
 }
 
 func setupTest(t TestingT, parentProvider provider.Provider, meta provider.TestMeta) *common.Common {
@@ -258,8 +475,8 @@ func setupTest(t TestingT, parentProvider provider.Provider, meta provider.TestM
 	testT.SetProvider(manager.NewProvider(cfg))
 
 	testT.Provider.TestContext()
-	meta.SetBeforeEach(parentTestMeta.GetBeforeEach())
-	meta.SetAfterEach(parentTestMeta.GetAfterEach())
+	meta.SetBeforeEach(parentTestMeta.GetBeforeEach()...)
+	meta.SetAfterEach(parentTestMeta.GetAfterEach()...)
 	if parentSuite := testT.Provider.GetSuiteMeta().GetParentSuite(); parentSuite != "" {
 		meta.GetResult().WithParentSuite(parentSuite)
 	}
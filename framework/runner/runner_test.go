@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	"github.com/louisun/allure-go-v2/allure"
+	"github.com/louisun/allure-go-v2/framework/core/allure_manager/rerun"
 	"github.com/louisun/allure-go-v2/framework/core/common"
 	"github.com/louisun/allure-go-v2/framework/core/constants"
 	"github.com/louisun/allure-go-v2/framework/provider"
@@ -108,8 +109,8 @@ type suiteMetaMockRunner struct {
 	namePrefix string
 	name       string
 	container  *allure.Container
-	hookBa     func(t provider.T)
-	hookAa     func(t provider.T)
+	hookBa     []provider.Hook
+	hookAa     []provider.Hook
 }
 
 func (m *suiteMetaMockRunner) GetPackageName() string {
@@ -136,27 +137,27 @@ func (m *suiteMetaMockRunner) GetContainer() *allure.Container {
 	return m.container
 }
 
-func (m *suiteMetaMockRunner) SetBeforeAll(hook func(provider.T)) {
-	m.hookBa = hook
+func (m *suiteMetaMockRunner) SetBeforeAll(hooks ...provider.Hook) {
+	m.hookBa = hooks
 }
 
-func (m *suiteMetaMockRunner) SetAfterAll(hook func(provider.T)) {
-	m.hookAa = hook
+func (m *suiteMetaMockRunner) SetAfterAll(hooks ...provider.Hook) {
+	m.hookAa = hooks
 }
 
-func (m *suiteMetaMockRunner) GetBeforeAll() func(provider.T) {
+func (m *suiteMetaMockRunner) GetBeforeAll() []provider.Hook {
 	return m.hookBa
 }
 
-func (m *suiteMetaMockRunner) GetAfterAll() func(provider.T) {
+func (m *suiteMetaMockRunner) GetAfterAll() []provider.Hook {
 	return m.hookAa
 }
 
 type testMetaMockRunner struct {
 	result    *allure.Result
 	container *allure.Container
-	be        func(t provider.T)
-	ae        func(t provider.T)
+	be        []provider.Hook
+	ae        []provider.Hook
 }
 
 func (m *testMetaMockRunner) GetResult() *allure.Result {
@@ -171,19 +172,19 @@ func (m *testMetaMockRunner) GetContainer() *allure.Container {
 	return m.container
 }
 
-func (m *testMetaMockRunner) SetBeforeEach(hook func(t provider.T)) {
-	m.be = hook
+func (m *testMetaMockRunner) SetBeforeEach(hooks ...provider.Hook) {
+	m.be = hooks
 }
 
-func (m *testMetaMockRunner) GetBeforeEach() func(t provider.T) {
+func (m *testMetaMockRunner) GetBeforeEach() []provider.Hook {
 	return m.be
 }
 
-func (m *testMetaMockRunner) SetAfterEach(hook func(t provider.T)) {
-	m.ae = hook
+func (m *testMetaMockRunner) SetAfterEach(hooks ...provider.Hook) {
+	m.ae = hooks
 }
 
-func (m *testMetaMockRunner) GetAfterEach() func(t provider.T) {
+func (m *testMetaMockRunner) GetAfterEach() []provider.Hook {
 	return m.ae
 }
 
@@ -258,10 +259,10 @@ func TestRunner_BeforeEach_noStep(t *testing.T) {
 
 	r := runner{tests: make(map[string]Test), internalT: newInternalTMock(constants.BeforeEachContextName)}
 
-	meta := &testMetaMockRunner{result: &allure.Result{}, container: allure.NewContainer(), be: func(t provider.T) {
+	meta := &testMetaMockRunner{result: &allure.Result{}, container: allure.NewContainer(), be: []provider.Hook{{Body: func(t provider.T) {
 		counter++
 		flag = true
-	}}
+	}}}}
 	r.tests["test"] = &testFunc{testMeta: meta, testBody: func(t provider.T) {}}
 	r.tests["test2"] = &testFunc{testMeta: meta, testBody: func(t provider.T) {}}
 
@@ -328,11 +329,11 @@ func TestRunner_AfterEach_withStep(t *testing.T) {
 
 	r := runner{tests: make(map[string]Test), internalT: newInternalTMock(constants.AfterEachContextName)}
 
-	meta := &testMetaMockRunner{result: &allure.Result{}, container: allure.NewContainer(), ae: func(t provider.T) {
+	meta := &testMetaMockRunner{result: &allure.Result{}, container: allure.NewContainer(), ae: []provider.Hook{{Body: func(t provider.T) {
 		t.NewStep("stepName")
 		flag = true
 		counter++
-	}}
+	}}}}
 
 	r.tests["test"] = &testFunc{testMeta: meta, testBody: func(t provider.T) {}}
 	r.tests["test2"] = &testFunc{testMeta: meta, testBody: func(t provider.T) {}}
@@ -504,3 +505,73 @@ func TestRunner_NewTest(t *testing.T) {
 	r.tests[testKey].GetBody()(r.t())
 	require.True(t, flag)
 }
+
+func TestRunner_filterFailed(t *testing.T) {
+	allureDir := "./allure-results"
+	defer os.RemoveAll(allureDir)
+
+	previous := allure.NewResult("failed", fmt.Sprintf("%s/failed", t.Name()))
+	previous.Status = allure.Failed
+	previous.HistoryID = "previousHistoryID"
+	passed := allure.NewResult("passed", fmt.Sprintf("%s/passed", t.Name()))
+	passed.Status = allure.Passed
+
+	var run []string
+	r := NewRunner(t, "suiteName").(*runner)
+	r.failedTests = rerun.NewFailedTests([]*allure.Result{previous, passed})
+	r.NewTest("failed", func(t provider.T) { run = append(run, "failed") })
+	r.NewTest("passed", func(t provider.T) { run = append(run, "passed") })
+	result := r.RunTests()
+
+	require.Equal(t, []string{"failed"}, run)
+	require.Len(t, result.GetAllTestResults(), 1)
+	require.Equal(t, "previousHistoryID", result.GetResultByName("failed").GetResult().HistoryID)
+}
+
+func TestRunner_stackedHooks(t *testing.T) {
+	allureDir := "./allure-results"
+	defer os.RemoveAll(allureDir)
+
+	var (
+		mu    sync.Mutex
+		calls []string
+	)
+	call := func(name string) func(t provider.T) {
+		return func(t provider.T) {
+			mu.Lock()
+			defer mu.Unlock()
+			calls = append(calls, name)
+			t.NewStep(name + " step")
+		}
+	}
+	r := NewRunner(t, "suiteName")
+	r.BeforeAll(call("beforeAll1"))
+	r.WithBeforeAll("db", call("beforeAll2"))
+	r.AfterAll(call("afterAll1"))
+	r.WithAfterAll("db", call("afterAll2"))
+	r.WithBeforeEach("login", call("beforeEach1"))
+	r.BeforeEach(call("beforeEach2"))
+	r.AfterEach(call("afterEach1"))
+	r.WithAfterEach("logout", call("afterEach2"))
+	r.NewTest("test", call("test"))
+	result := r.RunTests()
+
+	require.Equal(t, []string{
+		"beforeAll1", "beforeAll2",
+		"beforeEach1", "beforeEach2", "test", "afterEach2", "afterEach1",
+		"afterAll2", "afterAll1",
+	}, calls)
+
+	befores := result.GetContainer().Befores
+	require.Len(t, befores, 2)
+	require.Equal(t, "beforeAll1 step", befores[0].Name)
+	require.Equal(t, "db", befores[1].Name)
+	require.Len(t, befores[1].Steps, 1)
+	require.Equal(t, "beforeAll2 step", befores[1].Steps[0].Name)
+
+	testContainer := result.GetAllTestResults()[0].GetContainer()
+	require.Equal(t, "login", testContainer.Befores[0].Name)
+	require.Equal(t, "beforeEach2 step", testContainer.Befores[1].Name)
+	require.Equal(t, "logout", testContainer.Afters[0].Name)
+	require.Equal(t, "afterEach1 step", testContainer.Afters[1].Name)
+}
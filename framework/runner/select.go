@@ -0,0 +1,39 @@
+package runner
+
+import (
+	"github.com/louisun/allure-go-v2/allure"
+	"github.com/louisun/allure-go-v2/framework/core/allure_manager/selector"
+	"github.com/louisun/allure-go-v2/framework/provider"
+)
+
+// filterBySelector leaves tests whose labels match -allure-go.select expression.
+// Tests that don't match are reported as skipped if -allure-go.select-skipped is set.
+func (r *runner) filterBySelector(result SuiteResult) map[string]Test {
+	expr := selector.GetExpression()
+	if expr == nil {
+		return r.tests
+	}
+	tests := make(map[string]Test)
+	for name, test := range r.tests {
+		if selector.MatchResult(expr, test.GetMeta().GetResult()) {
+			tests[name] = test
+			continue
+		}
+		if selector.ReportSkipped() {
+			skipTest(selector.SkipMessage(), test.GetMeta(), result)
+		}
+	}
+	return tests
+}
+
+// skipTest reports the test as skipped without running it
+func skipTest(msg string, meta provider.TestMeta, result SuiteResult) {
+	result.GetContainer().AddChild(meta.GetResult().UUID)
+
+	tRes := NewTestResult(meta.GetResult(), meta.GetContainer())
+	tRes.GetResult().Status = allure.Skipped
+	tRes.GetResult().SetStatusMessage(msg)
+	tRes.GetResult().SetStatusTrace(msg)
+	_ = tRes.Print()
+	result.NewResult(tRes)
+}
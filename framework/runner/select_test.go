@@ -0,0 +1,67 @@
+package runner
+
+import (
+	"os"
+	"testing"
+
+	"github.com/louisun/allure-go-v2/allure"
+	"github.com/louisun/allure-go-v2/framework/provider"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunner_filterBySelector(t *testing.T) {
+	allureDir := "./allure-results"
+	defer os.RemoveAll(allureDir)
+	setFlag(t, "allure-go.select", "tag=smoke && !tag=legacy")
+
+	var run []string
+	r := NewRunner(t, "suiteName")
+	r.NewTest("smoke", func(t provider.T) { run = append(run, "smoke") }, "smoke")
+	r.NewTest("legacySmoke", func(t provider.T) { run = append(run, "legacySmoke") }, "smoke", "legacy")
+	r.NewTest("regression", func(t provider.T) { run = append(run, "regression") }, "regression")
+	result := r.RunTests()
+
+	require.Equal(t, []string{"smoke"}, run)
+	require.Len(t, result.GetAllTestResults(), 1)
+}
+
+func TestRunner_filterBySelector_skipped(t *testing.T) {
+	allureDir := "./allure-results"
+	defer os.RemoveAll(allureDir)
+	setFlag(t, "allure-go.select", "tag=smoke")
+	setFlag(t, "allure-go.select-skipped", "true")
+
+	var run []string
+	r := NewRunner(t, "suiteName")
+	r.NewTest("smoke", func(t provider.T) { run = append(run, "smoke") }, "smoke")
+	r.NewTest("regression", func(t provider.T) { run = append(run, "regression") }, "regression")
+	result := r.RunTests()
+
+	require.Equal(t, []string{"smoke"}, run)
+	require.Len(t, result.GetAllTestResults(), 2)
+
+	skipped := result.GetResultByName("regression").GetResult()
+	require.Equal(t, allure.Skipped, skipped.Status)
+	require.Equal(t, "Test is not selected by expression: tag=smoke", skipped.GetStatusMessage())
+	require.Contains(t, result.GetContainer().Children, skipped.UUID)
+}
+
+func TestRun_selector(t *testing.T) {
+	allureDir := "./allure-results"
+	defer os.RemoveAll(allureDir)
+	setFlag(t, "allure-go.select", "tag=smoke")
+
+	var run []string
+	Run(t, "regression", func(t provider.T) { run = append(run, "regression") }, "regression")
+	Run(t, "smoke", func(t provider.T) {
+		run = append(run, "smoke")
+		t.Run("nested", func(t provider.T) { run = append(run, "nested") })
+	}, "smoke")
+	require.Equal(t, []string{"smoke", "nested"}, run)
+
+	setFlag(t, "allure-go.select-skipped", "true")
+	result := Run(t, "regression", func(t provider.T) { run = append(run, "regression") }, "regression")
+	require.Equal(t, []string{"smoke", "nested"}, run)
+	require.Equal(t, allure.Skipped, result.Status)
+	require.Equal(t, "Test is not selected by expression: tag=smoke", result.GetStatusMessage())
+}
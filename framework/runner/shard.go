@@ -0,0 +1,171 @@
+package runner
+
+import (
+	"flag"
+	"fmt"
+	"hash/fnv"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/louisun/allure-go-v2/allure"
+)
+
+var (
+	shardFlag          = flag.String("allure-go.shard", "", "run only the i-th of n shards of the allure-go suite tests, e.g. 2/8")
+	shardDurationsFlag = flag.String("allure-go.shard-durations", "", "allure-results directory of the previous run to balance -allure-go.shard by test durations")
+)
+
+// shard describes the part of the tests to run
+type shard struct {
+	index int // 1-based index of the shard
+	total int
+}
+
+// parseShard parses -allure-go.shard value. Returns false if tests should not be sharded
+func parseShard(value string) (shard, bool, error) {
+	if value == "" {
+		return shard{}, false, nil
+	}
+	parts := strings.Split(value, "/")
+	if len(parts) != 2 {
+		return shard{}, false, fmt.Errorf("%q is not in i/n format", value)
+	}
+	index, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return shard{}, false, fmt.Errorf("%q is not in i/n format: %w", value, err)
+	}
+	total, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return shard{}, false, fmt.Errorf("%q is not in i/n format: %w", value, err)
+	}
+	if total < 1 || index < 1 || index > total {
+		return shard{}, false, fmt.Errorf("%q: shard index must be in range [1, n]", value)
+	}
+	return shard{index: index, total: total}, true, nil
+}
+
+// previousDurations keeps durations of the tests read from -allure-go.shard-durations directory
+// and shards the tests of the previous run are balanced to
+var previousDurations = struct {
+	once     sync.Once
+	balanced map[string]int
+	err      error
+}{}
+
+// balancedShards returns shards of the tests of the previous run balanced by their durations (see balanceShards).
+// All tests of the run are balanced at once, so the shards are balanced across suites and runners.
+func balancedShards(dir string, total int) (map[string]int, error) {
+	previousDurations.once.Do(func() {
+		results, err := allure.ReadResults(dir)
+		if err != nil {
+			previousDurations.err = err
+			return
+		}
+		durations := make(map[string]int64, len(results))
+		for _, result := range results {
+			if result.Stop >= result.Start {
+				durations[resultKey(result)] = result.Stop - result.Start
+			}
+		}
+		previousDurations.balanced = balanceShards(durations, total)
+	})
+	return previousDurations.balanced, previousDurations.err
+}
+
+// filterByShard leaves tests of the shard set by -allure-go.shard and labels them with the shard.
+// Tests are assigned to shards by stable hash of their ALLURE_ID or full name.
+// If -allure-go.shard-durations is set, tests of the previous run are balanced by their durations instead.
+func (r *runner) filterByShard() map[string]Test {
+	s, ok, err := parseShard(*shardFlag)
+	if err != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "allure-go: invalid value for -allure-go.shard: %s\n", err)
+		os.Exit(1)
+	}
+	if !ok {
+		return r.tests
+	}
+
+	var balanced map[string]int
+	if *shardDurationsFlag != "" {
+		balanced, err = balancedShards(*shardDurationsFlag, s.total)
+		if err != nil {
+			_, _ = fmt.Fprintf(os.Stderr, "allure-go: failed to read durations for -allure-go.shard-durations: %s\n", err)
+			os.Exit(1)
+		}
+	}
+
+	assigned := assignShards(r.tests, s.total, balanced)
+	tests := make(map[string]Test)
+	for name, test := range r.tests {
+		if assigned[name] == s.index {
+			test.GetMeta().GetResult().ReplaceLabel(allure.ShardLabel(s.index, s.total))
+			tests[name] = test
+		}
+	}
+	return tests
+}
+
+// assignShards returns 1-based shard index for every test. Tests found in balanced keep their shard,
+// other tests, e.g. new ones, are assigned by hash of their key.
+func assignShards(tests map[string]Test, total int, balanced map[string]int) map[string]int {
+	assigned := make(map[string]int, len(tests))
+	for name, test := range tests {
+		key := resultKey(test.GetMeta().GetResult())
+		if index, ok := balanced[key]; ok {
+			assigned[name] = index
+			continue
+		}
+		assigned[name] = int(hashKey(key)%uint64(total)) + 1
+	}
+	return assigned
+}
+
+// balanceShards returns 1-based shard index for every key of durations.
+// Keys are assigned greedily: the longest test goes to the least loaded shard.
+func balanceShards(durations map[string]int64, total int) map[string]int {
+	keys := make([]string, 0, len(durations))
+	for key := range durations {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		di, dj := durations[keys[i]], durations[keys[j]]
+		if di != dj {
+			return di > dj
+		}
+		return keys[i] < keys[j]
+	})
+
+	var (
+		balanced = make(map[string]int, len(keys))
+		loads    = make([]int64, total)
+	)
+	for _, key := range keys {
+		// ties are broken by the hash, so the first shard doesn't get all the tests of equal duration
+		least := int(hashKey(key) % uint64(total))
+		for i := range loads {
+			if loads[i] < loads[least] {
+				least = i
+			}
+		}
+		loads[least] += durations[key]
+		balanced[key] = least + 1
+	}
+	return balanced
+}
+
+// resultKey returns the key identifying the test across runs: ALLURE_ID label or full name of the test
+func resultKey(result *allure.Result) string {
+	if label, ok := result.GetFirstLabel(allure.AllureID); ok && label.GetValue() != "" {
+		return "ALLURE_ID:" + label.GetValue()
+	}
+	return result.FullName
+}
+
+func hashKey(key string) uint64 {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(key))
+	return h.Sum64()
+}
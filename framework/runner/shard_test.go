@@ -0,0 +1,143 @@
+package runner
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/louisun/allure-go-v2/allure"
+	"github.com/louisun/allure-go-v2/framework/provider"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseShard(t *testing.T) {
+	_, ok, err := parseShard("")
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	s, ok, err := parseShard("2/8")
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, shard{index: 2, total: 8}, s)
+
+	for _, value := range []string{"2", "a/8", "2/b", "0/8", "9/8", "1/0", "1/2/3"} {
+		_, _, err = parseShard(value)
+		require.Error(t, err, value)
+	}
+}
+
+func newShardTests(count int) map[string]Test {
+	tests := make(map[string]Test, count)
+	for i := 0; i < count; i++ {
+		name := fmt.Sprintf("Test%d", i)
+		tests[name] = &testFunc{testMeta: &testMetaMockRunner{result: allure.NewResult(name, "Suite/"+name)}}
+	}
+	return tests
+}
+
+func TestAssignShards_hash(t *testing.T) {
+	tests := newShardTests(40)
+	assigned := assignShards(tests, 4, nil)
+
+	require.Len(t, assigned, 40)
+	counts := make(map[int]int)
+	for _, index := range assigned {
+		require.True(t, index >= 1 && index <= 4)
+		counts[index]++
+	}
+	require.Len(t, counts, 4)
+	require.Equal(t, assigned, assignShards(newShardTests(40), 4, nil))
+}
+
+func TestAssignShards_allureID(t *testing.T) {
+	tests := newShardTests(1)
+	tests["Test0"].GetMeta().GetResult().AddLabel(allure.IDAllureLabel("42"))
+	renamed := newShardTests(1)
+	renamed["Test0"].GetMeta().GetResult().FullName = "Renamed/Test0"
+	renamed["Test0"].GetMeta().GetResult().AddLabel(allure.IDAllureLabel("42"))
+
+	require.Equal(t, assignShards(tests, 16, nil), assignShards(renamed, 16, nil))
+}
+
+func TestBalanceShards(t *testing.T) {
+	durations := map[string]int64{
+		"Suite/Test0": 100,
+		"Suite/Test1": 60,
+		"Suite/Test2": 50,
+		"Suite/Test3": 30,
+		"Suite/Test4": 60,
+	}
+	balanced := balanceShards(durations, 2)
+
+	loads := make(map[int]int64)
+	for key, index := range balanced {
+		loads[index] += durations[key]
+	}
+	require.Equal(t, int64(150), loads[1])
+	require.Equal(t, int64(150), loads[2])
+}
+
+func TestAssignShards_balanced(t *testing.T) {
+	tests := newShardTests(2)
+	balanced := map[string]int{"Suite/Test0": 2}
+	assigned := assignShards(tests, 2, balanced)
+
+	require.Equal(t, 2, assigned["Test0"])
+	// unknown test is assigned by hash
+	require.Equal(t, assignShards(tests, 2, nil)["Test1"], assigned["Test1"])
+}
+
+func TestAssignShards_balancedAcrossSuites(t *testing.T) {
+	newSuiteTests := func(suite string) map[string]Test {
+		tests := make(map[string]Test)
+		for _, name := range []string{"TestLong", "TestShort"} {
+			tests[name] = &testFunc{testMeta: &testMetaMockRunner{result: allure.NewResult(name, suite+"/"+name)}}
+		}
+		return tests
+	}
+	durations := map[string]int64{
+		"SuiteA/TestLong": 100, "SuiteA/TestShort": 10,
+		"SuiteB/TestLong": 100, "SuiteB/TestShort": 10,
+	}
+	balanced := balanceShards(durations, 2)
+
+	// every suite is filtered separately, but long tests of the suites don't meet in the same shard
+	loads := make(map[int]int64)
+	for _, suite := range []string{"SuiteA", "SuiteB"} {
+		for name, index := range assignShards(newSuiteTests(suite), 2, balanced) {
+			loads[index] += durations[suite+"/"+name]
+		}
+	}
+	require.Equal(t, int64(110), loads[1])
+	require.Equal(t, int64(110), loads[2])
+}
+
+func TestRunner_filterByShard(t *testing.T) {
+	allureDir := "./allure-results"
+	defer os.RemoveAll(allureDir)
+	setFlag(t, "allure-go.shard", "1/2")
+
+	run := make(map[string]bool)
+	r := NewRunner(t, "suiteName")
+	for i := 0; i < 10; i++ {
+		name := fmt.Sprintf("test%d", i)
+		r.NewTest(name, func(t provider.T) { run[name] = true })
+	}
+	result := r.RunTests()
+
+	require.NotEmpty(t, run)
+	require.Len(t, result.GetAllTestResults(), len(run))
+	for _, testResult := range result.GetAllTestResults() {
+		label, ok := testResult.GetResult().GetFirstLabel(allure.Shard)
+		require.True(t, ok)
+		require.Equal(t, "1/2", label.GetValue())
+	}
+}
+
+func TestResultKey(t *testing.T) {
+	result := allure.NewResult("test", "Suite/test")
+	require.Equal(t, "Suite/test", resultKey(result))
+
+	result.AddLabel(allure.IDAllureLabel("7"))
+	require.Equal(t, "ALLURE_ID:7", resultKey(result))
+}
@@ -6,12 +6,14 @@ import (
 	"os"
 	"reflect"
 	"regexp"
+	"runtime"
 	"strings"
-	"unsafe"
+	"time"
 
 	"github.com/louisun/allure-go-v2/allure"
 	"github.com/louisun/allure-go-v2/framework/core/allure_manager/adapter"
 	"github.com/louisun/allure-go-v2/framework/core/allure_manager/manager"
+	"github.com/louisun/allure-go-v2/framework/core/allure_manager/rerun"
 	"github.com/louisun/allure-go-v2/framework/core/allure_manager/testplan"
 	"github.com/louisun/allure-go-v2/framework/core/common"
 	"github.com/louisun/allure-go-v2/framework/provider"
@@ -33,7 +35,19 @@ func NewSuiteRunner(realT TestingT, packageName, suiteName string, suite TestSui
 	return newSuiteRunner(realT, packageName, suiteName, "", suite)
 }
 
-func newSuiteRunner(realT TestingT, packageName, suiteName, parentSuite string, suite TestSuite) TestRunner {
+// NewSuiteRunnerWithParentHooks returns runner of the suite nested into the parent suite.
+// BeforeEach hooks of the parent run before the suite's ones and AfterEach hooks of the parent run after them.
+// Tests of the suite are added to the parent suite's container, so its BeforeAll/AfterAll fixtures
+// are shown in the tests' results.
+func NewSuiteRunnerWithParentHooks(realT TestingT, packageName, suiteName, parentSuite string, suite, parent TestSuite) TestRunner {
+	r := newSuiteRunner(realT, packageName, suiteName, parentSuite, suite)
+	if parentRunner, ok := baseRunner(parent.GetRunner()); ok {
+		r.runner.inheritHooks(parentRunner)
+	}
+	return r
+}
+
+func newSuiteRunner(realT TestingT, packageName, suiteName, parentSuite string, suite TestSuite) *suiteRunner {
 	newT := common.NewT(realT)
 
 	callers := strings.Split(realT.Name(), "/")
@@ -51,9 +65,17 @@ func newSuiteRunner(realT TestingT, packageName, suiteName, parentSuite string,
 	}
 
 	testRunner := &runner{
-		internalT: newT,
-		testPlan:  testPlan,
-		tests:     make(map[string]Test),
+		internalT:   newT,
+		testPlan:    testPlan,
+		failedTests: rerun.GetFailedTests(),
+		tests:       make(map[string]Test),
+		retries:     suiteRetries(suite),
+		timeout:     suiteTimeout(suite),
+
+		parentContainers: launchContainers(),
+	}
+	if orderedSuite, ok := suite.(WithTestOrderSuite); ok {
+		testRunner.declaredOrder = orderedSuite.TestOrder()
 	}
 	r := &suiteRunner{
 		runner:      testRunner,
@@ -63,45 +85,90 @@ func newSuiteRunner(realT TestingT, packageName, suiteName, parentSuite string,
 	}
 	r = collectTests(r, suite)
 	r = collectParametrizedTests(r, suite)
+	r = collectFixtures(r, suite)
 	r = collectHooks(r, suite)
+	suite.SetRunner(r)
 
 	return r
 }
 
 // collectParametrizedTests executes InitTestParams function, finds test methods with tableTestPrefix,
-// gets map with parameters, gets map with parameterized tests,
-// replaces tests in runner with parameterized tests with results
+// gets cases of every table test (see findTableCases), gets map with parameterized tests,
+// replaces tests in runner with parameterized tests with results.
+// Cases returned by Param<Name> method are collected after BeforeAll hook
 func collectParametrizedTests(runner *suiteRunner, suite TestSuite) *suiteRunner {
 	if initTestParamsSuit, ok := suite.(WithTestPramsSuite); ok {
 		initTestParamsSuit.InitTestParams()
 	}
-	newTests := make(map[string]Test)
-	for k, v := range runner.tests {
-		newTests[k] = v
-	}
-	for name, test := range runner.tests {
-		if strings.HasPrefix(name, tableTestPrefix) {
-			params, err := getParams(runner.suite, name)
+	var (
+		tests = runner.tests
+		order = runner.order
+	)
+	runner.tests = make(map[string]Test)
+	runner.order = nil
+	for _, name := range order {
+		test := tests[name]
+		if !strings.HasPrefix(name, tableTestPrefix) {
+			runner.addTest(name, test)
+			continue
+		}
+		paramTest, ok := test.(parametrizedTest)
+		if !ok {
+			panic(fmt.Sprintf("missing interface implementaion (parametrizedTest) for test: %s", name))
+		}
+		collect, deferred, err := findTableCases(suite, paramTest.GetRawBody())
+		if err != nil {
+			panic(err)
+		}
+		if !deferred {
+			cases, err := collect()
 			if err != nil {
 				panic(err)
 			}
-			temp := getParamTests(test, params)
-			delete(newTests, name)
-			for tName, body := range temp {
-				tResult := body.GetMeta().GetResult()
-				id, ok := suite.FindAllureID(tName)
-				if ok {
-					tResult.AddLabel(allure.IDAllureLabel(id))
-				}
-				newTests[tName] = body
-				runner.internalT.GetProvider().GetSuiteMeta().GetContainer().AddChild(tResult.UUID)
-			}
+			runner.addTableCases(name, test, cases)
+			continue
 		}
+
+		// the name keeps place of the cases in the declared order until they are collected
+		tableTestName := name
+		runner.order = append(runner.order, tableTestName)
+		runner.deferred = append(runner.deferred, func() error {
+			cases, err := collect()
+			if err != nil {
+				return err
+			}
+			runner.addTableCases(tableTestName, test, cases)
+			return nil
+		})
 	}
-	runner.tests = newTests
 	return runner
 }
 
+// AddAllureIDMapping sets ALLURE_ID of the test or the case. Mapping is added to the suite too,
+// so it applies to the suite's methods and table test cases as well as to tests added to the runner.
+func (r *suiteRunner) AddAllureIDMapping(testName, allureID string) {
+	r.runner.AddAllureIDMapping(testName, allureID)
+	r.suite.AddAllureIDMapping(testName, allureID)
+	// methods and cases known before BeforeAll are already collected
+	if test, ok := r.tests[testName]; ok {
+		test.GetMeta().GetResult().ReplaceLabel(allure.IDAllureLabel(allureID))
+	}
+}
+
+// addTableCases adds cases of the table test to the runner at the place of the table test
+func (r *suiteRunner) addTableCases(tableTestName string, test Test, cases []tableCase) {
+	names, tests := getParamTests(test, uniqueCaseNames(cases))
+	for _, tName := range names {
+		tResult := tests[tName].GetMeta().GetResult()
+		id, ok := r.suite.FindAllureID(tName)
+		if ok {
+			tResult.ReplaceLabel(allure.IDAllureLabel(id))
+		}
+		r.internalT.GetProvider().GetSuiteMeta().GetContainer().AddChild(tResult.UUID)
+	}
+	r.addTestsAt(tableTestName, names, tests)
+}
+
 // collectTests filters suite methods according to set regular expression and
 // adds filtered methods to tests of runner
 func collectTests(runner *suiteRunner, suite TestSuite) *suiteRunner {
@@ -120,7 +187,7 @@ func collectTests(runner *suiteRunner, suite TestSuite) *suiteRunner {
 			os.Exit(1)
 		}
 
-		if !ok {
+		if !ok || isOrderDeclaration(suite, method) {
 			continue
 		}
 
@@ -129,37 +196,45 @@ func collectTests(runner *suiteRunner, suite TestSuite) *suiteRunner {
 		if ok {
 			testMeta.GetResult().AddLabel(allure.IDAllureLabel(id))
 		}
-		runner.tests[method.Name] = &testMethod{
+		runner.addTest(method.Name, &testMethod{
 			testMeta: testMeta,
 			testBody: method,
 			callArgs: []reflect.Value{
 				reflect.ValueOf(suite),
 			},
-		}
+		})
 	}
 	return runner
 }
 
+// isOrderDeclaration returns true if the method is TestOrder of WithTestOrderSuite.
+// Method named TestOrder with the test's signature is collected as a usual test.
+func isOrderDeclaration(suite TestSuite, method reflect.Method) bool {
+	_, ok := suite.(WithTestOrderSuite)
+	return ok && method.Name == testOrderMethod
+}
+
 type parametrizedTest interface {
 	GetRawBody() reflect.Method
 	GetArgs() []reflect.Value
 	GetMeta() provider.TestMeta
 }
 
-// getParamTests create instance of TestAdapter for every param from params
-// and returns map whose elements are a pair (<param name>, <pointer to instance of testMethod>)
-func getParamTests(parentTest Test, params map[string]interface{}) map[string]Test {
+// getParamTests create instance of TestAdapter for every case from cases
+// and returns map whose elements are a pair (<case name>, <pointer to instance of testMethod>)
+// and names of the cases in order of the cases
+func getParamTests(parentTest Test, cases []tableCase) (names []string, res map[string]Test) {
 	if paramTest, ok := parentTest.(parametrizedTest); ok {
 		var (
 			suiteName   string
 			packageName string
 			tags        []string
 
-			res           = make(map[string]Test)
 			parentMeta    = paramTest.GetMeta()
 			result        = parentMeta.GetResult()
 			suiteFullName = result.FullName
 		)
+		res = make(map[string]Test)
 		if suite, ok := result.GetFirstLabel(allure.Suite); ok {
 			suiteName = suite.GetValue()
 		}
@@ -171,68 +246,115 @@ func getParamTests(parentTest Test, params map[string]interface{}) map[string]Te
 			tags = append(tags, tag.GetValue())
 		}
 
-		for pName, param := range params {
-			meta := adapter.NewTestMeta(suiteFullName, suiteName, pName, packageName, tags...)
+		for _, c := range cases {
+			meta := adapter.NewTestMeta(suiteFullName, suiteName, c.name, packageName, tags...)
 			if parentSuite, ok := result.GetFirstLabel(allure.ParentSuite); ok {
 				meta.GetResult().ReplaceLabel(parentSuite)
 			}
-			res[pName] = &testMethod{
+			meta.GetResult().Parameters = append(meta.GetResult().Parameters, c.params...)
+			if c.allureID != "" {
+				meta.GetResult().AddLabel(allure.IDAllureLabel(c.allureID))
+			}
+			names = append(names, c.name)
+			res[c.name] = &testMethod{
 				testMeta: meta,
 				testBody: paramTest.GetRawBody(),
-				callArgs: append(paramTest.GetArgs(), reflect.ValueOf(param)),
+				callArgs: append(paramTest.GetArgs(), c.args...),
 			}
 		}
-		return res
+		return names, res
 	}
 	panic(fmt.Sprintf("missing interface implementaion (parametrizedTest) for test: %s", parentTest.GetMeta().GetResult().Name))
 }
 
-// getParams checks that the parameter extending the suite is of the slice type
-// and returns a map whose elements are a pair
-// (<method name without tableTestPrefix>_<value of slice element>, <value of slice element>)
-func getParams(suite TestSuite, methodName string) (res map[string]interface{}, err error) {
-	var (
-		structSuite = reflect.ValueOf(suite).Elem()
-		paramName   = strings.TrimPrefix(methodName, tableTestPrefix)
-	)
-	res = make(map[string]interface{})
-	params := structSuite.FieldByName(tableParamPrefix + paramName)
-	if params.Kind() != reflect.Slice {
-		err = fmt.Errorf("cannot find appropriate params for %s", methodName)
-		return
-	}
-	for i := 0; i < params.Len(); i++ {
-		paramV := params.Index(i)
-		param := reflect.NewAt(paramV.Type(), unsafe.Pointer(paramV.UnsafeAddr())).Elem().Interface()
-		pName := fmt.Sprintf("%s_%+v", paramName, param)
-		if len(pName) > 150 { // workaround for t.TempDir()
-			pName = pName[:150]
+// suiteRetries returns retries policy of the suite. Test's retries have priority over the suite's ones,
+// suite's retries have priority over -allure-go.retries flag
+func suiteRetries(suite TestSuite) func(testName string) int {
+	return func(testName string) int {
+		if testRetries, ok := suite.(WithTestRetriesSuite); ok {
+			if retries, found := testRetries.FindRetries(testName); found {
+				return retries
+			}
+		}
+		if retriesSuite, ok := suite.(WithRetriesSuite); ok {
+			return retriesSuite.Retries()
 		}
-		res[pName] = param
+		return defaultRetries(testName)
 	}
-	return
 }
 
+// collectHooks adds hooks of the suite and of the structs embedded into it.
+// Hooks of the embedded structs are added first and named after their type,
+// so before hooks of the base suite run before the suite's ones and after hooks run after them.
 func collectHooks(runner *suiteRunner, suite TestSuite) *suiteRunner {
-	if beforeAll, ok := suite.(AllureBeforeSuite); ok {
-		runner.BeforeAll(beforeAll.BeforeAll)
-	}
+	owners := hookOwners(reflect.ValueOf(suite))
+	for i, owner := range owners {
+		var name string
+		if i < len(owners)-1 {
+			name = owner.Type().Elem().Name()
+		}
+		hooks := owner.Interface()
 
-	if beforeEach, ok := suite.(AllureBeforeTest); ok {
-		runner.BeforeEach(beforeEach.BeforeEach)
-	}
+		if beforeAll, ok := hooks.(AllureBeforeSuite); ok && declaresMethod(owner, "BeforeAll") {
+			runner.WithBeforeAll(name, beforeAll.BeforeAll)
+		}
 
-	if afterAll, ok := suite.(AllureAfterSuite); ok {
-		runner.AfterAll(afterAll.AfterAll)
-	}
+		if beforeEach, ok := hooks.(AllureBeforeTest); ok && declaresMethod(owner, "BeforeEach") {
+			runner.WithBeforeEach(name, beforeEach.BeforeEach)
+		}
 
-	if afterEach, ok := suite.(AllureAfterTest); ok {
-		runner.AfterEach(afterEach.AfterEach)
+		if afterAll, ok := hooks.(AllureAfterSuite); ok && declaresMethod(owner, "AfterAll") {
+			runner.WithAfterAll(name, afterAll.AfterAll)
+		}
+
+		if afterEach, ok := hooks.(AllureAfterTest); ok && declaresMethod(owner, "AfterEach") {
+			runner.WithAfterEach(name, afterEach.AfterEach)
+		}
 	}
 
 	return runner
 }
 
+// hookOwners returns pointers to the structs embedded into the struct, the deepest first, and the struct itself
+func hookOwners(ptr reflect.Value) []reflect.Value {
+	if ptr.Kind() != reflect.Ptr || ptr.IsNil() || ptr.Elem().Kind() != reflect.Struct {
+		return nil
+	}
+	var (
+		owners     []reflect.Value
+		structType = ptr.Elem().Type()
+	)
+	for i := 0; i < structType.NumField(); i++ {
+		field := structType.Field(i)
+		if !field.Anonymous {
+			continue
+		}
+		value := exportedField(ptr.Elem(), field)
+		switch {
+		case value.Kind() == reflect.Struct:
+			owners = append(owners, hookOwners(value.Addr())...)
+		case value.Kind() == reflect.Ptr && value.Type().Elem().Kind() == reflect.Struct:
+			owners = append(owners, hookOwners(value)...)
+		}
+	}
+	return append(owners, ptr)
+}
+
+// declaresMethod returns true if the method is declared by the type of the value itself and is not promoted
+// from the embedded struct. Methods promoted by the compiler are wrappers without source position.
+func declaresMethod(value reflect.Value, name string) bool {
+	method, ok := value.Type().MethodByName(name)
+	if !ok {
+		return false
+	}
+	fn := runtime.FuncForPC(method.Func.Pointer())
+	if fn == nil {
+		return true
+	}
+	file, _ := fn.FileLine(fn.Entry())
+	return file != "<autogenerated>"
+}
+
 var matchMethod = flag.String("allure-go.m", "", "regular expression to select tests of the allure-go suite to run")
 
 // Filtering method according to set regular expression
@@ -248,3 +370,13 @@ func methodFilter(name string) (bool, error) {
 	}
 	return regexp.MatchString(*matchMethod, name)
 }
+
+var testTimeout = flag.Duration("allure-go.timeout", 0, "default timeout of the allure-go test body, e.g. 30s. Timed out tests are marked broken")
+
+// suiteTimeout returns default timeout of the suite's tests. Suite's timeout has priority over -allure-go.timeout flag
+func suiteTimeout(suite TestSuite) time.Duration {
+	if timeoutSuite, ok := suite.(WithTimeoutSuite); ok {
+		return timeoutSuite.Timeout()
+	}
+	return *testTimeout
+}
@@ -39,16 +39,29 @@ func (sr *suiteResult) GetAllTestResults() []TestResult {
 	return sr.TestResults
 }
 
-// GetResultByName searches result by name and returns it
+// GetResultByName searches result by name and returns it.
+// If test was retried, result of the last attempt is returned
 func (sr *suiteResult) GetResultByName(name string) TestResult {
+	if attempts := sr.GetAttemptsByName(name); len(attempts) > 0 {
+		return attempts[len(attempts)-1]
+	}
+	return nil
+}
+
+// GetAttemptsByName searches results of all test attempts by name and returns them in order of run
+func (sr *suiteResult) GetAttemptsByName(name string) []TestResult {
+	sr.mu.Lock()
+	defer sr.mu.Unlock()
+
+	var attempts []TestResult
 	for _, tr := range sr.TestResults {
 		if result := tr.GetResult(); result != nil {
 			if result.Name == name {
-				return tr
+				attempts = append(attempts, tr)
 			}
 		}
 	}
-	return nil
+	return attempts
 }
 
 // GetResultByUUID searches result by UUID and returns it
@@ -0,0 +1,100 @@
+// Package steps provides typed helpers running steps, which return values and errors.
+// Helpers work on both provider.T and provider.StepCtx and run the function with WithNewStep,
+// so panics of the function break the step and the test as usual.
+package steps
+
+import (
+	"errors"
+
+	"github.com/louisun/allure-go-v2/allure"
+	"github.com/louisun/allure-go-v2/framework/provider"
+)
+
+// Parent is the test (provider.T) or the step (provider.StepCtx) the step is nested into
+type Parent interface {
+	WithNewStep(stepName string, step func(sCtx provider.StepCtx), params ...*allure.Parameter)
+}
+
+// brokenError marks the error, which breaks the step instead of failing it
+type brokenError struct {
+	err error
+}
+
+func (e *brokenError) Error() string {
+	return e.err.Error()
+}
+
+func (e *brokenError) Unwrap() error {
+	return e.err
+}
+
+// Broken marks the error returned by the step's function, so Do and Must break the step and the test instead of failing them.
+// It's meant for errors of the environment (e.g. unavailable dependency), not of the tested code.
+func Broken(err error) error {
+	if err == nil {
+		return nil
+	}
+	return &brokenError{err: err}
+}
+
+// Get runs fn as the step and returns its result. Error of fn marks the step failed (or broken, see Broken)
+// and is returned, but the test goes on.
+func Get[T any](parent Parent, name string, fn func(sCtx provider.StepCtx) (T, error), params ...*allure.Parameter) (result T, err error) {
+	parent.WithNewStep(name, func(sCtx provider.StepCtx) {
+		result, err = fn(sCtx)
+		if err != nil {
+			markStep(sCtx.CurrentStep(), err)
+		}
+	}, params...)
+	return result, err
+}
+
+// Run runs fn as the step. Error of fn marks the step failed (or broken, see Broken) and is returned, but the test goes on.
+func Run(parent Parent, name string, fn func(sCtx provider.StepCtx) error, params ...*allure.Parameter) error {
+	_, err := Get(parent, name, func(sCtx provider.StepCtx) (struct{}, error) {
+		return struct{}{}, fn(sCtx)
+	}, params...)
+	return err
+}
+
+// Do runs fn as the step and returns its result. Error of fn fails the step and the test and stops the test.
+// Error marked by Broken breaks them instead.
+func Do[T any](parent Parent, name string, fn func(sCtx provider.StepCtx) (T, error), params ...*allure.Parameter) (result T) {
+	parent.WithNewStep(name, func(sCtx provider.StepCtx) {
+		var err error
+		result, err = fn(sCtx)
+		if err == nil {
+			return
+		}
+		if isBroken(err) {
+			sCtx.Breakf("%s", err)
+			return
+		}
+		sCtx.Errorf("%s", err)
+		sCtx.FailNow()
+	}, params...)
+	return result
+}
+
+// Must runs fn as the step. Error of fn fails the step and the test and stops the test.
+// Error marked by Broken breaks them instead.
+func Must(parent Parent, name string, fn func(sCtx provider.StepCtx) error, params ...*allure.Parameter) {
+	Do(parent, name, func(sCtx provider.StepCtx) (struct{}, error) {
+		return struct{}{}, fn(sCtx)
+	}, params...)
+}
+
+func isBroken(err error) bool {
+	var broken *brokenError
+	return errors.As(err, &broken)
+}
+
+// markStep marks the step failed or broken by the error and attaches the error to it
+func markStep(step *allure.Step, err error) {
+	if isBroken(err) {
+		step.Broken()
+	} else {
+		step.Failed()
+	}
+	step.WithAttachments(allure.NewAttachment("Error", allure.Text, []byte(err.Error())))
+}
@@ -0,0 +1,99 @@
+package steps
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/louisun/allure-go-v2/allure"
+	"github.com/louisun/allure-go-v2/framework/provider"
+	"github.com/louisun/allure-go-v2/framework/runner"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGet(t *testing.T) {
+	allureDir := "./allure-results"
+	defer os.RemoveAll(allureDir)
+
+	result := runner.Run(t, "Get", func(t provider.T) {
+		user, err := Get(t, "Get user", func(sCtx provider.StepCtx) (string, error) {
+			return "John", nil
+		})
+		require.NoError(t, err)
+		require.Equal(t, "John", user)
+
+		t.WithNewStep("Users", func(sCtx provider.StepCtx) {
+			err = Run(sCtx, "Delete user", func(sCtx provider.StepCtx) error {
+				return errors.New("forbidden")
+			})
+			require.EqualError(t, err, "forbidden")
+
+			_, err = Get(sCtx, "Get user", func(sCtx provider.StepCtx) (string, error) {
+				return "", Broken(errors.New("connection refused"))
+			})
+			require.EqualError(t, err, "connection refused")
+		})
+
+		require.Equal(t, 42, Do(t, "Count users", func(sCtx provider.StepCtx) (int, error) {
+			return 42, nil
+		}))
+		Must(t, "Check users", func(sCtx provider.StepCtx) error {
+			return nil
+		})
+	})
+
+	require.Equal(t, allure.Passed, result.Status)
+	require.Len(t, result.Steps, 4)
+	require.Equal(t, allure.Passed, result.Steps[0].Status)
+	require.Equal(t, allure.Failed, result.Steps[1].Steps[0].Status)
+	require.Len(t, result.Steps[1].Steps[0].Attachments, 1)
+	require.Equal(t, allure.Broken, result.Steps[1].Steps[1].Status)
+	require.Equal(t, "Count users", result.Steps[2].Name)
+}
+
+type stepCtxMock struct {
+	provider.StepCtx
+
+	errors  []string
+	broken  bool
+	failNow bool
+}
+
+func (m *stepCtxMock) Errorf(format string, args ...interface{}) {
+	m.errors = append(m.errors, fmt.Sprintf(format, args...))
+}
+
+func (m *stepCtxMock) Breakf(format string, args ...interface{}) {
+	m.broken = true
+	m.errors = append(m.errors, fmt.Sprintf(format, args...))
+}
+
+func (m *stepCtxMock) FailNow() {
+	m.failNow = true
+}
+
+type parentMock struct {
+	sCtx *stepCtxMock
+}
+
+func (m *parentMock) WithNewStep(stepName string, step func(sCtx provider.StepCtx), params ...*allure.Parameter) {
+	step(m.sCtx)
+}
+
+func TestDo_error(t *testing.T) {
+	parent := &parentMock{sCtx: &stepCtxMock{}}
+	Do(parent, "Get user", func(sCtx provider.StepCtx) (string, error) {
+		return "", errors.New("not found")
+	})
+	require.Equal(t, []string{"not found"}, parent.sCtx.errors)
+	require.True(t, parent.sCtx.failNow)
+	require.False(t, parent.sCtx.broken)
+
+	parent = &parentMock{sCtx: &stepCtxMock{}}
+	Must(parent, "Get user", func(sCtx provider.StepCtx) error {
+		return Broken(errors.New("connection refused"))
+	})
+	require.Equal(t, []string{"connection refused"}, parent.sCtx.errors)
+	require.True(t, parent.sCtx.broken)
+}
@@ -12,6 +12,7 @@ import (
 type Suite struct {
 	runner          runner.TestRunner
 	allureIDMapping map[string]string
+	retriesMapping  map[string]int
 }
 
 func (s *Suite) AddAllureIDMapping(testName, allureID string) {
@@ -26,6 +27,19 @@ func (s *Suite) FindAllureID(testName string) (id string, ok bool) {
 	return
 }
 
+// AddRetriesMapping sets how many times failed test will be retried
+func (s *Suite) AddRetriesMapping(testName string, retries int) {
+	if s.retriesMapping == nil {
+		s.retriesMapping = make(map[string]int)
+	}
+	s.retriesMapping[testName] = retries
+}
+
+func (s *Suite) FindRetries(testName string) (retries int, ok bool) {
+	retries, ok = s.retriesMapping[testName]
+	return
+}
+
 func (s *Suite) GetRunner() runner.TestRunner {
 	return s.runner
 }
@@ -41,6 +55,15 @@ func (s *Suite) RunSuite(t provider.T, suite runner.TestSuite) runner.SuiteResul
 	return runner.NewSuiteRunnerWithParent(t.RealT(), getPackage(2), cleanName(getSuiteName(suite)), parentName, suite).RunTests()
 }
 
+// RunSuiteWithParentHooks runs the suite nested into the current one. BeforeEach/AfterEach hooks of the current suite
+// wrap tests of the nested suite, and BeforeAll/AfterAll fixtures of the current suite are shown in their results.
+func (s *Suite) RunSuiteWithParentHooks(t provider.T, suite runner.TestSuite) runner.SuiteResult {
+	t.SkipOnPrint()
+	parts := strings.Split(t.RealT().Name(), "/")
+	parentName := parts[len(parts)-3]
+	return runner.NewSuiteRunnerWithParentHooks(t.RealT(), getPackage(2), cleanName(getSuiteName(suite)), parentName, suite, s).RunTests()
+}
+
 func (s *Suite) RunNamedSuite(t provider.T, suiteName string, suite runner.TestSuite) runner.SuiteResult {
 	t.SkipOnPrint()
 	parts := strings.Split(t.RealT().Name(), "/")
@@ -1,11 +1,18 @@
 package suite
 
 import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/ioutil"
 	"os"
+	"path/filepath"
 	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
+	"github.com/louisun/allure-go-v2/allure"
 	"github.com/louisun/allure-go-v2/framework/provider"
 	"github.com/louisun/allure-go-v2/framework/runner"
 	"github.com/stretchr/testify/require"
@@ -168,3 +175,723 @@ func TestSuiteRunner_StartTimeAndDurationOfConsistentTests(t *testing.T) {
 	require.Equal(t, time.UnixMilli(results[0].GetResult().Stop-results[0].GetResult().Start).Second(), 1)
 	require.Equal(t, time.UnixMilli(results[1].GetResult().Stop-results[1].GetResult().Start).Second(), 1)
 }
+
+type TestSuiteRetries struct {
+	Suite
+	mu sync.Mutex
+
+	beforeEach int
+	afterEach  int
+	attempts   map[string]int
+}
+
+func (s *TestSuiteRetries) Retries() int {
+	return 2
+}
+
+func (s *TestSuiteRetries) attempt(name string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.attempts[name]++
+	return s.attempts[name]
+}
+
+func (s *TestSuiteRetries) BeforeEach(t provider.T) {
+	s.beforeEach++
+}
+
+func (s *TestSuiteRetries) AfterEach(t provider.T) {
+	s.afterEach++
+}
+
+func (s *TestSuiteRetries) TestAssertFailsOnce(t provider.T) {
+	t.Assert().Equal(2, s.attempt(t.Name()))
+}
+
+func (s *TestSuiteRetries) TestRequireFailsTwice(t provider.T) {
+	t.Require().Equal(3, s.attempt(t.Name()))
+}
+
+func (s *TestSuiteRetries) TestPassed(t provider.T) {
+	s.attempt(t.Name())
+}
+
+func TestSuiteRunner_Retries(t *testing.T) {
+	allureDir := "./allure-results"
+	defer os.RemoveAll(allureDir)
+
+	suite := &TestSuiteRetries{attempts: make(map[string]int)}
+	r := runner.NewSuiteRunner(t, "packageName", "suiteName", suite)
+	suiteResult := r.RunTests()
+
+	require.Equal(t, 2, suite.attempts["TestAssertFailsOnce"])
+	require.Equal(t, 3, suite.attempts["TestRequireFailsTwice"])
+	require.Equal(t, 1, suite.attempts["TestPassed"])
+	require.Equal(t, 6, suite.beforeEach)
+	require.Equal(t, 6, suite.afterEach)
+	require.Len(t, suiteResult.GetAllTestResults(), 6)
+	require.Len(t, suiteResult.GetContainer().Children, 6)
+
+	attempts := suiteResult.GetAttemptsByName("TestRequireFailsTwice")
+	require.Len(t, attempts, 3)
+	require.Equal(t, allure.Failed, attempts[0].GetResult().Status)
+	require.Equal(t, allure.Failed, attempts[1].GetResult().Status)
+	require.Equal(t, allure.Passed, attempts[2].GetResult().Status)
+	require.False(t, attempts[0].GetResult().StatusDetails.Flaky)
+	require.True(t, attempts[2].GetResult().StatusDetails.Flaky)
+	require.Equal(t, attempts[2], suiteResult.GetResultByName("TestRequireFailsTwice"))
+	for _, attempt := range attempts[1:] {
+		require.NotEqual(t, attempts[0].GetResult().UUID, attempt.GetResult().UUID)
+		require.Equal(t, attempts[0].GetResult().HistoryID, attempt.GetResult().HistoryID)
+		require.Equal(t, attempts[0].GetResult().FullName, attempt.GetResult().FullName)
+	}
+
+	attempts = suiteResult.GetAttemptsByName("TestAssertFailsOnce")
+	require.Len(t, attempts, 2)
+	require.Equal(t, allure.Failed, attempts[0].GetResult().Status)
+	require.Equal(t, allure.Passed, attempts[1].GetResult().Status)
+	require.True(t, attempts[1].GetResult().StatusDetails.Flaky)
+
+	passed := suiteResult.GetResultByName("TestPassed")
+	require.Equal(t, allure.Passed, passed.GetResult().Status)
+	require.False(t, passed.GetResult().StatusDetails.Flaky)
+}
+
+type TestSuiteTestRetries struct {
+	Suite
+	attempts int
+}
+
+func (s *TestSuiteTestRetries) TestFailsOnce(t provider.T) {
+	s.attempts++
+	t.Require().Equal(2, s.attempts)
+}
+
+func TestSuiteRunner_TestRetries(t *testing.T) {
+	allureDir := "./allure-results"
+	defer os.RemoveAll(allureDir)
+
+	suite := new(TestSuiteTestRetries)
+	suite.AddRetriesMapping("TestFailsOnce", 1)
+	r := runner.NewSuiteRunner(t, "packageName", "suiteName", suite)
+	suiteResult := r.RunTests()
+
+	require.Equal(t, 2, suite.attempts)
+	require.Len(t, suiteResult.GetAttemptsByName("TestFailsOnce"), 2)
+}
+
+type TestSuiteTimeout struct {
+	Suite
+	// attempts is changed by the abandoned goroutine of the first attempt
+	attempts int32
+	release  chan struct{}
+}
+
+func (s *TestSuiteTimeout) Retries() int {
+	return 1
+}
+
+func (s *TestSuiteTimeout) Timeout() time.Duration {
+	return 50 * time.Millisecond
+}
+
+func (s *TestSuiteTimeout) TestHangsOnce(t provider.T) {
+	if atomic.AddInt32(&s.attempts, 1) == 1 {
+		<-s.release
+	}
+}
+
+func TestSuiteRunner_Timeout(t *testing.T) {
+	allureDir := "./allure-results"
+	defer os.RemoveAll(allureDir)
+
+	suite := &TestSuiteTimeout{release: make(chan struct{})}
+	defer close(suite.release)
+	r := runner.NewSuiteRunner(t, "packageName", "suiteName", suite)
+	suiteResult := r.RunTests()
+
+	require.Equal(t, int32(2), atomic.LoadInt32(&suite.attempts))
+	attempts := suiteResult.GetAttemptsByName("TestHangsOnce")
+	require.Len(t, attempts, 2)
+
+	timedOut := attempts[0].GetResult()
+	require.Equal(t, allure.Broken, timedOut.Status)
+	require.Equal(t, "Test timed out after 50ms", timedOut.GetStatusMessage())
+	require.NotEmpty(t, timedOut.Attachments)
+	require.Equal(t, "Goroutines", timedOut.Attachments[len(timedOut.Attachments)-1].Name)
+	require.Equal(t, allure.Passed, attempts[1].GetResult().Status)
+}
+
+type TestSuiteTimeoutFromBody struct {
+	Suite
+	attempts int32
+	release  chan struct{}
+}
+
+func (s *TestSuiteTimeoutFromBody) Retries() int {
+	return 1
+}
+
+func (s *TestSuiteTimeoutFromBody) TestHangsOnce(t provider.T) {
+	t.WithTimeout(50 * time.Millisecond)
+	if atomic.AddInt32(&s.attempts, 1) == 1 {
+		<-s.release
+	}
+}
+
+func TestSuiteRunner_TimeoutFromBody(t *testing.T) {
+	allureDir := "./allure-results"
+	defer os.RemoveAll(allureDir)
+
+	suite := &TestSuiteTimeoutFromBody{release: make(chan struct{})}
+	defer close(suite.release)
+	r := runner.NewSuiteRunner(t, "packageName", "suiteName", suite)
+	suiteResult := r.RunTests()
+
+	attempts := suiteResult.GetAttemptsByName("TestHangsOnce")
+	require.Len(t, attempts, 2)
+	require.Equal(t, allure.Broken, attempts[0].GetResult().Status)
+	require.Equal(t, "Test timed out after 50ms", attempts[0].GetResult().GetStatusMessage())
+	require.Equal(t, allure.Passed, attempts[1].GetResult().Status)
+}
+
+type TestSuiteTimeoutAbandoned struct {
+	Suite
+	attempts int32
+	release  chan struct{}
+}
+
+func (s *TestSuiteTimeoutAbandoned) Retries() int {
+	return 1
+}
+
+func (s *TestSuiteTimeoutAbandoned) Timeout() time.Duration {
+	return 20 * time.Millisecond
+}
+
+func (s *TestSuiteTimeoutAbandoned) AfterEach(t provider.T) {
+	t.WithNewStep("after each", func(sCtx provider.StepCtx) {
+		sCtx.WithNewParameters("key", "value")
+	})
+}
+
+func (s *TestSuiteTimeoutAbandoned) TestKeepsReporting(t provider.T) {
+	if atomic.AddInt32(&s.attempts, 1) > 1 {
+		return
+	}
+	for {
+		select {
+		case <-s.release:
+			return
+		default:
+		}
+		t.WithNewStep("step", func(sCtx provider.StepCtx) {
+			sCtx.WithNewAttachment("attachment", allure.Text, []byte("content"))
+		})
+		t.WithNewAttachment("attachment", allure.Text, []byte("content"))
+		t.WithNewParameters("key", "value")
+		t.LogStep("log")
+	}
+}
+
+// TestSuiteRunner_TimeoutAbandoned is meant to be run with -race: abandoned body keeps reporting
+// while the result is printed
+func TestSuiteRunner_TimeoutAbandoned(t *testing.T) {
+	allureDir := "./allure-results"
+	defer os.RemoveAll(allureDir)
+
+	suite := &TestSuiteTimeoutAbandoned{release: make(chan struct{})}
+	defer close(suite.release)
+	r := runner.NewSuiteRunner(t, "packageName", "suiteName", suite)
+	suiteResult := r.RunTests()
+
+	attempts := suiteResult.GetAttemptsByName("TestKeepsReporting")
+	require.Len(t, attempts, 2)
+	require.Equal(t, allure.Broken, attempts[0].GetResult().Status)
+	require.Equal(t, "Test timed out after 20ms", attempts[0].GetResult().GetStatusMessage())
+
+	// let abandoned body report after the test end
+	time.Sleep(20 * time.Millisecond)
+}
+
+type TestSuiteStepTimeoutAbandoned struct {
+	Suite
+	attempts int32
+	release  chan struct{}
+}
+
+func (s *TestSuiteStepTimeoutAbandoned) Retries() int {
+	return 1
+}
+
+func (s *TestSuiteStepTimeoutAbandoned) AfterEach(t provider.T) {
+	t.WithNewStep("after each", func(sCtx provider.StepCtx) {
+		sCtx.WithNewParameters("key", "value")
+	})
+}
+
+func (s *TestSuiteStepTimeoutAbandoned) TestStepKeepsReporting(t provider.T) {
+	if atomic.AddInt32(&s.attempts, 1) > 1 {
+		return
+	}
+	t.WithNewTimeoutStep("hanging step", 20*time.Millisecond, func(sCtx provider.StepCtx) {
+		for {
+			select {
+			case <-s.release:
+				return
+			default:
+			}
+			sCtx.WithNewStep("step", func(sCtx provider.StepCtx) {
+				sCtx.WithNewAttachment("attachment", allure.Text, []byte("content"))
+			})
+			sCtx.WithNewAttachment("attachment", allure.Text, []byte("content"))
+			sCtx.WithNewParameters("key", "value")
+			sCtx.LogStep("log")
+		}
+	})
+}
+
+// TestSuiteRunner_StepTimeoutAbandoned is meant to be run with -race: abandoned step keeps reporting
+// while the result is printed
+func TestSuiteRunner_StepTimeoutAbandoned(t *testing.T) {
+	allureDir := "./allure-results"
+	defer os.RemoveAll(allureDir)
+
+	suite := &TestSuiteStepTimeoutAbandoned{release: make(chan struct{})}
+	defer close(suite.release)
+	r := runner.NewSuiteRunner(t, "packageName", "suiteName", suite)
+	suiteResult := r.RunTests()
+
+	attempts := suiteResult.GetAttemptsByName("TestStepKeepsReporting")
+	require.Len(t, attempts, 2)
+	require.Equal(t, allure.Broken, attempts[0].GetResult().Status)
+
+	// let abandoned step report after the test end
+	time.Sleep(20 * time.Millisecond)
+}
+
+type TestSuiteOrder struct {
+	Suite
+	order []string
+}
+
+func (s *TestSuiteOrder) TestOrder() []string {
+	return []string{"TestThird", "TestFirst"}
+}
+
+func (s *TestSuiteOrder) TestFirst(t provider.T) {
+	s.order = append(s.order, "TestFirst")
+}
+
+func (s *TestSuiteOrder) TestSecond(t provider.T) {
+	s.order = append(s.order, "TestSecond")
+}
+
+func (s *TestSuiteOrder) TestThird(t provider.T) {
+	s.order = append(s.order, "TestThird")
+}
+
+func TestSuiteRunner_TestOrder(t *testing.T) {
+	allureDir := "./allure-results"
+	defer os.RemoveAll(allureDir)
+
+	suite := new(TestSuiteOrder)
+	r := runner.NewSuiteRunner(t, "packageName", "suiteName", suite)
+	suiteResult := r.RunTests()
+
+	require.Equal(t, []string{"TestThird", "TestFirst", "TestSecond"}, suite.order)
+	require.Len(t, suiteResult.GetAllTestResults(), 3)
+}
+
+type TestSuiteOrderTest struct {
+	Suite
+	run bool
+}
+
+func (s *TestSuiteOrderTest) TestOrder(t provider.T) {
+	s.run = true
+}
+
+func TestSuiteRunner_TestOrderTest(t *testing.T) {
+	allureDir := "./allure-results"
+	defer os.RemoveAll(allureDir)
+
+	suite := new(TestSuiteOrderTest)
+	r := runner.NewSuiteRunner(t, "packageName", "suiteName", suite)
+	suiteResult := r.RunTests()
+
+	require.True(t, suite.run)
+	require.NotNil(t, suiteResult.GetResultByName("TestOrder"))
+}
+
+type TestSuiteDryRun struct {
+	Suite
+	ParamCases  []int
+	run         bool
+	paramCalled bool
+}
+
+func (s *TestSuiteDryRun) InitTestParams() {
+	s.ParamCases = []int{1, 2}
+}
+
+func (s *TestSuiteDryRun) BeforeAll(t provider.T) {
+	s.run = true
+}
+
+func (s *TestSuiteDryRun) BeforeEach(t provider.T) {
+	s.run = true
+}
+
+func (s *TestSuiteDryRun) TestMethod(t provider.T) {
+	s.run = true
+}
+
+func (s *TestSuiteDryRun) TableTestCases(t provider.T, param int) {
+	s.run = true
+}
+
+// ParamDeferred is still called in dry-run to list the cases
+func (s *TestSuiteDryRun) ParamDeferred() []string {
+	s.paramCalled = true
+	return []string{"a"}
+}
+
+func (s *TestSuiteDryRun) TableTestDeferred(t provider.T, param string) {
+	s.run = true
+}
+
+func setDryRun(t *testing.T, manifest string) {
+	require.NoError(t, flag.Set("allure-go.dry-run", "true"))
+	require.NoError(t, flag.Set("allure-go.dry-run-manifest", manifest))
+	t.Cleanup(func() {
+		_ = flag.Set("allure-go.dry-run", "false")
+		_ = flag.Set("allure-go.dry-run-manifest", "")
+	})
+}
+
+func TestSuiteRunner_DryRun(t *testing.T) {
+	allureDir := "./allure-results"
+	defer os.RemoveAll(allureDir)
+	setDryRun(t, "")
+
+	suite := new(TestSuiteDryRun)
+	suite.AddAllureIDMapping("TestMethod", "123")
+	r := runner.NewSuiteRunner(t, "packageName", "suiteName", suite)
+	suiteResult := r.RunTests()
+
+	require.False(t, suite.run)
+	require.True(t, suite.paramCalled)
+	require.Len(t, suiteResult.GetAllTestResults(), 4)
+	for _, testResult := range suiteResult.GetAllTestResults() {
+		require.Equal(t, allure.Skipped, testResult.GetResult().Status)
+		require.Contains(t, suiteResult.GetContainer().Children, testResult.GetResult().UUID)
+	}
+	id, ok := suiteResult.GetResultByName("TestMethod").GetResult().GetFirstLabel(allure.AllureID)
+	require.True(t, ok)
+	require.Equal(t, "123", id.GetValue())
+	require.NotNil(t, suiteResult.GetResultByName("Cases_1"))
+	require.NotNil(t, suiteResult.GetResultByName("Cases_2"))
+	require.NotNil(t, suiteResult.GetResultByName("Deferred_a"))
+}
+
+func TestSuiteRunner_DryRunManifest(t *testing.T) {
+	allureDir := "./allure-results"
+	defer os.RemoveAll(allureDir)
+	manifestPath := filepath.Join(t.TempDir(), "manifest.json")
+	setDryRun(t, manifestPath)
+
+	suite := new(TestSuiteDryRun)
+	suite.AddAllureIDMapping("TestMethod", "123")
+	r := runner.NewSuiteRunner(t, "packageName", "suiteName", suite)
+	suiteResult := r.RunTests()
+
+	require.False(t, suite.run)
+	require.Empty(t, suiteResult.GetAllTestResults())
+
+	data, err := ioutil.ReadFile(manifestPath)
+	require.NoError(t, err)
+	var entries []runner.ManifestEntry
+	require.NoError(t, json.Unmarshal(data, &entries))
+	require.Len(t, entries, 4)
+	require.Equal(t, "Cases_1", entries[0].Name)
+	require.Equal(t, "Cases_2", entries[1].Name)
+	require.Equal(t, "Deferred_a", entries[2].Name)
+	require.Equal(t, "TestMethod", entries[3].Name)
+	require.Contains(t, entries[3].FullName, "suiteName/TestMethod")
+
+	var allureID string
+	for _, label := range entries[3].Labels {
+		if label.Name == allure.AllureID.ToString() {
+			allureID = label.GetValue()
+		}
+	}
+	require.Equal(t, "123", allureID)
+}
+
+type cityCase struct {
+	City       string `allure:"param"`
+	Population int    `allure:"param"`
+	Comment    string
+}
+
+func (c cityCase) Name() string {
+	return c.City
+}
+
+type TestSuiteTableTestNames struct {
+	Suite
+	ParamCities []cityCase
+	run         []string
+}
+
+func (s *TestSuiteTableTestNames) InitTestParams() {
+	s.ParamCities = []cityCase{
+		{City: "Moscow", Population: 12},
+		{City: "Kazan", Population: 1},
+		{City: "Moscow", Population: 13},
+	}
+}
+
+func (s *TestSuiteTableTestNames) TableTestCities(t provider.T, city cityCase) {
+	s.run = append(s.run, t.Name())
+}
+
+func TestSuiteRunner_TableTestNamesAndParameters(t *testing.T) {
+	allureDir := "./allure-results"
+	defer os.RemoveAll(allureDir)
+
+	suite := new(TestSuiteTableTestNames)
+	r := runner.NewSuiteRunner(t, "packageName", "suiteName", suite)
+	suiteResult := r.RunTests()
+
+	require.Len(t, suite.run, 3)
+	require.Len(t, suiteResult.GetAllTestResults(), 3)
+
+	moscow := suiteResult.GetResultByName("Moscow").GetResult()
+	require.Equal(t, []*allure.Parameter{
+		allure.NewParameter("City", "Moscow"),
+		allure.NewParameter("Population", 12),
+	}, moscow.Parameters)
+
+	moscowDup := suiteResult.GetResultByName("Moscow#01").GetResult()
+	require.Equal(t, []*allure.Parameter{
+		allure.NewParameter("City", "Moscow"),
+		allure.NewParameter("Population", 13),
+	}, moscowDup.Parameters)
+	require.NotNil(t, suiteResult.GetResultByName("Kazan"))
+}
+
+type TestSuiteTableTestSources struct {
+	Suite
+	users               []string
+	ParamMatrix_Browser []string
+	ParamMatrix_Width   []int
+	run                 []string
+}
+
+func (s *TestSuiteTableTestSources) BeforeAll(t provider.T) {
+	s.users = []string{"admin", "guest"}
+}
+
+func (s *TestSuiteTableTestSources) InitTestParams() {
+	s.ParamMatrix_Browser = []string{"chrome", "firefox"}
+	s.ParamMatrix_Width = []int{800, 1200}
+}
+
+func (s *TestSuiteTableTestSources) ParamUsers() []string {
+	return s.users
+}
+
+func (s *TestSuiteTableTestSources) TableTestUsers(t provider.T, user string) {
+	s.run = append(s.run, "Users:"+user)
+}
+
+func (s *TestSuiteTableTestSources) TableTestMatrix(t provider.T, browser string, width int) {
+	s.run = append(s.run, fmt.Sprintf("Matrix:%s:%d", browser, width))
+}
+
+type town struct {
+	City       string `yaml:"city"`
+	Population int    `yaml:"population"`
+}
+
+func (s *TestSuiteTableTestSources) TableTestTowns(t provider.T, town town) {
+	s.run = append(s.run, "Towns:"+town.City)
+}
+
+func TestSuiteRunner_TableTestSources(t *testing.T) {
+	allureDir := "./allure-results"
+	defer os.RemoveAll(allureDir)
+
+	suite := new(TestSuiteTableTestSources)
+	r := runner.NewSuiteRunner(t, "packageName", "suiteName", suite)
+	suiteResult := r.RunTests()
+
+	require.ElementsMatch(t, []string{
+		"Users:admin", "Users:guest",
+		"Matrix:chrome:800", "Matrix:chrome:1200", "Matrix:firefox:800", "Matrix:firefox:1200",
+		"Towns:Moscow", "Towns:Kazan",
+	}, suite.run)
+	require.Len(t, suiteResult.GetAllTestResults(), 8)
+
+	require.Equal(t, []*allure.Parameter{allure.NewParameter("Users", "guest")},
+		suiteResult.GetResultByName("Users_guest").GetResult().Parameters)
+	require.Equal(t, []*allure.Parameter{
+		allure.NewParameter("Browser", "firefox"),
+		allure.NewParameter("Width", 800),
+	}, suiteResult.GetResultByName("Matrix_firefox_800").GetResult().Parameters)
+	require.Equal(t, []*allure.Parameter{
+		allure.NewParameter("city", "Kazan"),
+		allure.NewParameter("population", "1"),
+	}, suiteResult.GetResultByName("Towns_{City:Kazan Population:1}").GetResult().Parameters)
+}
+
+func TestSuiteRunner_AllureIDMappingOfRunner(t *testing.T) {
+	allureDir := "./allure-results"
+	defer os.RemoveAll(allureDir)
+
+	suite := new(TestSuiteTableTestSources)
+	r := runner.NewSuiteRunner(t, "packageName", "suiteName", suite)
+	// case collected with the suite and case collected after BeforeAll
+	r.AddAllureIDMapping("Matrix_chrome_800", "1")
+	r.AddAllureIDMapping("Users_admin", "2")
+	suiteResult := r.RunTests()
+
+	for name, expected := range map[string]string{
+		"Matrix_chrome_800": "1",
+		"Users_admin":       "2",
+	} {
+		id, ok := suiteResult.GetResultByName(name).GetResult().GetFirstLabel(allure.AllureID)
+		require.True(t, ok, name)
+		require.Equal(t, expected, id.GetValue(), name)
+	}
+}
+
+type BaseHooksSuite struct {
+	Suite
+	calls []string
+}
+
+func (s *BaseHooksSuite) BeforeAll(t provider.T) {
+	s.calls = append(s.calls, "base BeforeAll")
+}
+
+func (s *BaseHooksSuite) AfterAll(t provider.T) {
+	s.calls = append(s.calls, "base AfterAll")
+}
+
+func (s *BaseHooksSuite) BeforeEach(t provider.T) {
+	s.calls = append(s.calls, "base BeforeEach")
+}
+
+type TestSuiteEmbeddedHooks struct {
+	BaseHooksSuite
+}
+
+func (s *TestSuiteEmbeddedHooks) BeforeAll(t provider.T) {
+	s.calls = append(s.calls, "BeforeAll")
+}
+
+func (s *TestSuiteEmbeddedHooks) AfterAll(t provider.T) {
+	s.calls = append(s.calls, "AfterAll")
+}
+
+func (s *TestSuiteEmbeddedHooks) TestHooks(t provider.T) {
+	s.calls = append(s.calls, "TestHooks")
+}
+
+func TestSuiteRunner_EmbeddedHooks(t *testing.T) {
+	allureDir := "./allure-results"
+	defer os.RemoveAll(allureDir)
+
+	suite := new(TestSuiteEmbeddedHooks)
+	r := runner.NewSuiteRunner(t, "packageName", "suiteName", suite)
+	r.RunTests()
+
+	require.Equal(t, []string{
+		"base BeforeAll", "BeforeAll",
+		"base BeforeEach", "TestHooks",
+		"AfterAll", "base AfterAll",
+	}, suite.calls)
+}
+
+type fixtureDB struct {
+	name string
+}
+
+type fixtureRepo struct {
+	db *fixtureDB
+}
+
+var fixtureCalls []string
+
+func init() {
+	runner.RegisterFixture("db", func(t provider.T) (*fixtureDB, func()) {
+		fixtureCalls = append(fixtureCalls, "set up db")
+		return &fixtureDB{name: "test"}, func() {
+			fixtureCalls = append(fixtureCalls, "tear down db")
+		}
+	})
+	runner.RegisterFixture("repo", func(t provider.T, db *fixtureDB) (*fixtureRepo, func()) {
+		fixtureCalls = append(fixtureCalls, "set up repo")
+		return &fixtureRepo{db: db}, func() {
+			fixtureCalls = append(fixtureCalls, "tear down repo")
+		}
+	}, "db")
+}
+
+type TestSuiteFixtures struct {
+	Suite
+
+	DB   *fixtureDB   `allure:"fixture,name=db,scope=suite"`
+	repo *fixtureRepo `allure:"fixture,name=repo"`
+}
+
+func (s *TestSuiteFixtures) BeforeEach(t provider.T) {
+	fixtureCalls = append(fixtureCalls, "BeforeEach")
+}
+
+func (s *TestSuiteFixtures) TestFirst(t provider.T) {
+	require.Same(t, s.DB, s.repo.db)
+	fixtureCalls = append(fixtureCalls, "TestFirst")
+}
+
+func (s *TestSuiteFixtures) TestSecond(t provider.T) {
+	require.Same(t, s.DB, s.repo.db)
+	fixtureCalls = append(fixtureCalls, "TestSecond")
+}
+
+func (s *TestSuiteFixtures) TestOrder() []string {
+	return []string{"TestFirst", "TestSecond"}
+}
+
+func TestSuiteRunner_Fixtures(t *testing.T) {
+	allureDir := "./allure-results"
+	defer os.RemoveAll(allureDir)
+	fixtureCalls = nil
+
+	result := runner.NewSuiteRunner(t, "packageName", "suiteName", new(TestSuiteFixtures)).RunTests()
+
+	require.Equal(t, []string{
+		"set up db",
+		"set up repo", "BeforeEach", "TestFirst", "tear down repo",
+		"set up repo", "BeforeEach", "TestSecond", "tear down repo",
+		"tear down db",
+	}, fixtureCalls)
+
+	befores, afters := result.GetContainer().Befores, result.GetContainer().Afters
+	require.Equal(t, "fixtures", befores[0].Name)
+	require.Equal(t, "Set up db", befores[0].Steps[0].Name)
+	require.Equal(t, "fixtures", afters[len(afters)-1].Name)
+	require.Equal(t, "Tear down db", afters[len(afters)-1].Steps[0].Name)
+	require.Len(t, result.GetAllTestResults(), 2)
+	for _, test := range result.GetAllTestResults() {
+		require.Equal(t, allure.Passed, test.GetResult().Status)
+		befores, afters = test.GetContainer().Befores, test.GetContainer().Afters
+		require.Equal(t, "fixtures", befores[0].Name)
+		require.Equal(t, "Set up repo", befores[0].Steps[0].Name)
+		require.Equal(t, "fixtures", afters[len(afters)-1].Name)
+		require.Equal(t, "Tear down repo", afters[len(afters)-1].Steps[0].Name)
+	}
+}
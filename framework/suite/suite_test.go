@@ -82,3 +82,63 @@ func TestSuite_RunSuite(t *testing.T) {
 	require.True(t, suite.s2.afterEach)
 	require.True(t, suite.s2.afterAll)
 }
+
+type TestSuiteParentHooks struct {
+	Suite
+	calls       []string
+	childResult runner.SuiteResult
+}
+
+func (s *TestSuiteParentHooks) BeforeAll(t provider.T) {
+	s.calls = append(s.calls, "parent BeforeAll")
+}
+
+func (s *TestSuiteParentHooks) BeforeEach(t provider.T) {
+	s.calls = append(s.calls, "parent BeforeEach")
+}
+
+func (s *TestSuiteParentHooks) AfterEach(t provider.T) {
+	s.calls = append(s.calls, "parent AfterEach")
+}
+
+func (s *TestSuiteParentHooks) TestNested(t provider.T) {
+	s.childResult = s.RunSuiteWithParentHooks(t, &TestSuiteChildHooks{parent: s})
+}
+
+type TestSuiteChildHooks struct {
+	Suite
+	parent *TestSuiteParentHooks
+}
+
+func (s *TestSuiteChildHooks) BeforeEach(t provider.T) {
+	s.parent.calls = append(s.parent.calls, "child BeforeEach")
+}
+
+func (s *TestSuiteChildHooks) AfterEach(t provider.T) {
+	s.parent.calls = append(s.parent.calls, "child AfterEach")
+}
+
+func (s *TestSuiteChildHooks) TestChild(t provider.T) {
+	s.parent.calls = append(s.parent.calls, "TestChild")
+}
+
+func TestSuite_RunSuiteWithParentHooks(t *testing.T) {
+	allureDir := "./allure-results"
+	defer os.RemoveAll(allureDir)
+
+	suite := new(TestSuiteParentHooks)
+	result := runner.NewSuiteRunner(t, "packageName", "suiteName", suite).RunTests()
+
+	require.Equal(t, []string{
+		"parent BeforeAll",
+		"parent BeforeEach",
+		"parent BeforeEach", "child BeforeEach", "TestChild", "child AfterEach", "parent AfterEach",
+		"parent AfterEach",
+	}, suite.calls)
+
+	childResults := suite.childResult.GetAllTestResults()
+	require.Len(t, childResults, 1)
+	childTest := childResults[0]
+	require.Contains(t, result.GetContainer().Children, childTest.GetResult().UUID)
+	require.Equal(t, "suiteName", childTest.GetContainer().Befores[0].Name)
+}